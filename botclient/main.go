@@ -14,35 +14,150 @@ import (
 	"github.com/marianogappa/chinchon-backend/server"
 )
 
-func Bot(playerID int, address string, bot chinchon.Bot) {
-	// Open the WebSocket connection, and send a hello message.
-	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%v/ws", address), nil)
+const (
+	// DefaultMaxRetries is how many times in a row a bot client retries joining a game before giving
+	// up.
+	DefaultMaxRetries = 5
+
+	// DefaultInitialBackoff is how long a bot client waits before its first retry to join a game. The
+	// wait doubles on each retry, up to DefaultMaxBackoff.
+	DefaultInitialBackoff = 250 * time.Millisecond
+	DefaultMaxBackoff     = 10 * time.Second
+)
+
+type client struct {
+	playerID int
+	address  string
+	bot      chinchon.Bot
+
+	games          int
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	// isAwaitingNextGame is true once the result of the current game was recorded, until the next
+	// game starts. It survives reconnections, so that a game isn't counted twice.
+	isAwaitingNextGame bool
+}
+
+// WithGames makes the bot play the given number of games. Playing more than one game requires a
+// server that starts a rematch when a game ends (see server.WithAutoRematch).
+func WithGames(games int) func(*client) {
+	return func(c *client) {
+		c.games = games
+	}
+}
+
+// WithMaxRetries sets how many times in a row the bot retries joining a game before giving up.
+func WithMaxRetries(maxRetries int) func(*client) {
+	return func(c *client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithBackoff sets how long the bot waits before retrying to join a game: initial before the first
+// retry, doubling on each retry up to max.
+func WithBackoff(initial, max time.Duration) func(*client) {
+	return func(c *client) {
+		c.initialBackoff = initial
+		c.maxBackoff = max
+	}
+}
+
+// Bot connects a bot to the server at address as the given player, and plays until the game ends.
+func Bot(playerID int, address string, bot chinchon.Bot, opts ...func(*client)) {
+	results, err := Play(playerID, address, bot, opts...)
+	log.Println("Results:", results)
 	if err != nil {
-		log.Fatalf("Failed to connect to WebSocket server: %v", err)
+		log.Fatal(err)
+	}
+}
+
+// Play connects a bot to the server at address as the given player, and plays one game (or as many
+// as set with WithGames), returning the aggregate results.
+//
+// If the connection fails or drops mid-game, the bot reconnects with exponential backoff and
+// rejoins the game where it left off. It gives up after WithMaxRetries failed attempts in a row,
+// returning the results so far along with the error.
+func Play(playerID int, address string, bot chinchon.Bot, opts ...func(*client)) (Results, error) {
+	c := &client{
+		playerID:       playerID,
+		address:        address,
+		bot:            bot,
+		games:          1,
+		maxRetries:     DefaultMaxRetries,
+		initialBackoff: DefaultInitialBackoff,
+		maxBackoff:     DefaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	var (
+		results  = Results{}
+		backoff  = c.initialBackoff
+		failures = 0
+	)
+	for results.GamesPlayed < c.games {
+		hasJoined, err := c.play(&results)
+		if err == nil {
+			continue
+		}
+		if hasJoined {
+			// The connection dropped mid-game, so rejoin straight away.
+			failures, backoff = 0, c.initialBackoff
+			log.Println("Lost connection to the server, rejoining:", err)
+			continue
+		}
+		if failures >= c.maxRetries {
+			return results, fmt.Errorf("failed to join the game after %v retries: %w", failures, err)
+		}
+		failures++
+		log.Printf("Failed to join the game, retrying in %v: %v", backoff, err)
+		time.Sleep(backoff)
+		backoff = min(2*backoff, c.maxBackoff)
+	}
+	return results, nil
+}
+
+// play connects to the server and plays until the bot has played all its games, or the connection
+// fails. It returns whether the bot joined the game, i.e. received a game state, before failing.
+func (c *client) play(results *Results) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%v/ws", c.address), nil)
+	if err != nil {
+		return false, err
 	}
 	defer conn.Close()
 
 	// Hello message is meant to tell the server who we are, and request game state.
 	// Game could be in progress (this could be a reconnection).
-	if err := server.WsSend(conn, server.NewMessageHello(playerID)); err != nil {
-		log.Fatal(err)
+	if err := server.WsSend(conn, server.NewMessageHello(c.playerID)); err != nil {
+		return false, err
 	}
 
 	// On each iteration
-	for {
+	for hasJoined := false; ; hasJoined = true {
 		clientGameState, err := server.WsReadMessage[chinchon.ClientGameState, server.MessageHeresGameState](conn, server.MessageTypeHeresGameState)
 		if err != nil {
-			log.Fatal(err)
+			return hasJoined, err
 		}
 
 		if clientGameState.IsGameEnded {
-			return
+			if !c.isAwaitingNextGame {
+				c.isAwaitingNextGame = true
+				results.record(*clientGameState)
+				log.Printf("Game %v of %v ended: %v", results.GamesPlayed, c.games, results)
+			}
+			if results.GamesPlayed >= c.games {
+				return true, nil
+			}
+			continue
 		}
+		c.isAwaitingNextGame = false
 
-		botAction := bot.ChooseAction(*clientGameState)
-
+		// Wait for the next game state if the bot has nothing to do, e.g. during the opponent's turn.
+		botAction := c.bot.ChooseAction(*clientGameState)
 		if botAction == nil {
-			time.Sleep(1 * time.Second)
 			continue
 		}
 
@@ -50,7 +165,7 @@ func Bot(playerID int, address string, bot chinchon.Bot) {
 
 		// Send the action to the server.
 		if err := server.WsSend(conn, server.MessageAction{WebsocketMessage: server.WebsocketMessage{Type: server.MessageTypeAction}, Action: bs}); err != nil {
-			log.Fatal(err)
+			return true, err
 		}
 	}
 }
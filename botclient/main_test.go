@@ -0,0 +1,111 @@
+//go:build !tinygo
+// +build !tinygo
+
+package botclient
+
+import (
+	"io"
+	"log/slog"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/examplebot/newbot"
+	"github.com/marianogappa/chinchon-backend/server"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer() *httptest.Server {
+	s := server.New("0",
+		server.WithBroadcastWindow(0),
+		server.WithAutoRematch(true),
+		server.WithAutoRematchCountdown(10*time.Millisecond),
+		server.WithIllegalActionLogger(slog.New(slog.NewTextHandler(io.Discard, nil))),
+	)
+	return httptest.NewUnstartedServer(s.Handler())
+}
+
+type playResult struct {
+	results Results
+	err     error
+}
+
+// playBots plays both bots against each other on the server at address, returning their results.
+func playBots(t *testing.T, address string, opts ...func(*client)) [2]playResult {
+	t.Helper()
+	done := make(chan struct{}, 2)
+	var outcomes [2]playResult
+	for playerID := range outcomes {
+		go func() {
+			bot := newbot.New(newbot.WithSeed(int64(playerID)))
+			outcomes[playerID].results, outcomes[playerID].err = Play(playerID, address, bot, opts...)
+			done <- struct{}{}
+		}()
+	}
+	for range outcomes {
+		select {
+		case <-done:
+		case <-time.After(time.Minute):
+			t.Fatal("bots didn't finish playing")
+		}
+	}
+	return outcomes
+}
+
+func TestBotsPlayACoupleOfGames(t *testing.T) {
+	ts := newTestServer()
+	ts.Start()
+	defer ts.Close()
+
+	outcomes := playBots(t, strings.TrimPrefix(ts.URL, "http://"), WithGames(2))
+
+	for _, outcome := range outcomes {
+		require.NoError(t, outcome.err)
+		require.Equal(t, 2, outcome.results.GamesPlayed)
+		require.Equal(t, 2, outcome.results.Wins+outcome.results.Losses+outcome.results.Draws)
+	}
+	require.Equal(t, outcomes[0].results.Wins, outcomes[1].results.Losses)
+	require.Equal(t, outcomes[0].results.Draws, outcomes[1].results.Draws)
+}
+
+func TestBotRetriesUntilTheServerIsUp(t *testing.T) {
+	// Reserve an address, and only start serving on it after the bots have started retrying.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	ts := newTestServer()
+	defer ts.Close()
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			return
+		}
+		ts.Listener.Close()
+		ts.Listener = listener
+		ts.Start()
+	}()
+
+	outcomes := playBots(t, address, WithBackoff(20*time.Millisecond, 50*time.Millisecond), WithMaxRetries(20))
+
+	for _, outcome := range outcomes {
+		require.NoError(t, outcome.err)
+		require.Equal(t, 1, outcome.results.GamesPlayed)
+	}
+}
+
+func TestBotGivesUpAfterMaxRetries(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	results, err := Play(0, address, newbot.New(), WithBackoff(time.Millisecond, time.Millisecond), WithMaxRetries(2))
+
+	require.ErrorContains(t, err, "after 2 retries")
+	require.Equal(t, Results{}, results)
+}
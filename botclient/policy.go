@@ -0,0 +1,35 @@
+//go:build !tinygo
+// +build !tinygo
+
+package botclient
+
+import (
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// RecordPolicy asks the bot to choose an action for each of the positions, returning the move
+// notation of each choice (see chinchon.EncodeActionsLog), e.g. "0X3c". Positions where the bot
+// chooses no action are recorded as "-".
+//
+// Saved as a golden file, the record of a fixed set of positions can be diffed against a later
+// version of the bot's, to catch unintended changes to its strategy.
+func RecordPolicy(bot chinchon.Bot, positions []chinchon.ClientGameState) []string {
+	record := make([]string, len(positions))
+	for i, position := range positions {
+		record[i] = policyNotation(bot.ChooseAction(position))
+	}
+	return record
+}
+
+func policyNotation(action chinchon.Action) string {
+	if action == nil {
+		return "-"
+	}
+	notation, err := chinchon.EncodeActionsLog([]chinchon.ActionLog{
+		{PlayerID: action.GetPlayerID(), Action: chinchon.SerializeAction(action)},
+	})
+	if err != nil {
+		return "-"
+	}
+	return notation
+}
@@ -0,0 +1,53 @@
+//go:build !tinygo
+// +build !tinygo
+
+package botclient
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/marianogappa/chinchon-backend/examplebot/newbot"
+	"github.com/stretchr/testify/require"
+)
+
+// policyPositions returns the positions of a seeded game played with random actions, from the
+// point of view of the player to act.
+func policyPositions(t *testing.T, count int) []chinchon.ClientGameState {
+	var (
+		rng       = rand.New(rand.NewSource(7))
+		g         = chinchon.New(chinchon.WithSeed(7))
+		positions = []chinchon.ClientGameState{}
+	)
+	for len(positions) < count && !g.IsGameEnded {
+		actions := g.CalculatePossibleActions()
+		require.NotEmpty(t, actions)
+		action := actions[rng.Intn(len(actions))]
+		positions = append(positions, g.ToClientGameState(action.GetPlayerID()))
+		require.NoError(t, g.RunAction(action))
+	}
+	return positions
+}
+
+func TestRecordPolicyIsStableForADeterministicBot(t *testing.T) {
+	positions := policyPositions(t, 60)
+
+	record := RecordPolicy(newbot.New(newbot.WithSeed(1)), positions)
+	require.Len(t, record, len(positions))
+	require.Equal(t, record, RecordPolicy(newbot.New(newbot.WithSeed(1)), positions))
+
+	for i, notation := range record {
+		actionsLog, err := chinchon.DecodeActionsLog(notation)
+		require.NoError(t, err)
+		require.Len(t, actionsLog, 1)
+		require.Equal(t, positions[i].YouPlayerID, actionsLog[0].PlayerID)
+	}
+}
+
+func TestRecordPolicyRecordsNoActionAsADash(t *testing.T) {
+	position := chinchon.New().ToClientGameState(0)
+	position.PossibleActions = nil
+
+	require.Equal(t, []string{"-"}, RecordPolicy(newbot.New(), []chinchon.ClientGameState{position}))
+}
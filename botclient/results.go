@@ -0,0 +1,37 @@
+//go:build !tinygo
+// +build !tinygo
+
+package botclient
+
+import (
+	"fmt"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// Results are the aggregate results of the games a bot played.
+type Results struct {
+	GamesPlayed int `json:"gamesPlayed"`
+	Wins        int `json:"wins"`
+	Losses      int `json:"losses"`
+
+	// Draws are games that ended without a winner, e.g. when a stall was detected.
+	Draws int `json:"draws"`
+}
+
+// record adds the result of an ended game, from the bot's point of view.
+func (r *Results) record(gs chinchon.ClientGameState) {
+	r.GamesPlayed++
+	switch gs.WinnerPlayerID {
+	case gs.YouPlayerID:
+		r.Wins++
+	case -1:
+		r.Draws++
+	default:
+		r.Losses++
+	}
+}
+
+func (r Results) String() string {
+	return fmt.Sprintf("%v games played: %v wins, %v losses, %v draws", r.GamesPlayed, r.Wins, r.Losses, r.Draws)
+}
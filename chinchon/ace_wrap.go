@@ -0,0 +1,96 @@
+package chinchon
+
+import "sort"
+
+// AceWrap controls whether runs may wrap around from the king (12) to the ace (1).
+type AceWrap int
+
+const (
+	// AceWrapNone never lets runs wrap: the ace is always the lowest card, e.g. 1-2-3. It's the
+	// default, and the traditional rule.
+	AceWrapNone AceWrap = iota
+
+	// AceWrapLowOnly lets runs continue from the king onto the ace, as long as the ace is still
+	// followed by the 2, e.g. 12-1-2. The ace can't end a run above the king, e.g. 11-12-1.
+	AceWrapLowOnly
+
+	// AceWrapHighAllowed lets runs wrap freely, including the ace ending a run above the king, e.g.
+	// 11-12-1 or 12-1-2.
+	AceWrapHighAllowed
+)
+
+// WithAceWrap sets whether runs may wrap around from the king to the ace (see AceWrap).
+func WithAceWrap(aceWrap AceWrap) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleAceWrap = aceWrap
+	}
+}
+
+// isRun returns true if the cards form a run under the ace wrap rule: 3 or more cards of the same
+// suit with consecutive numbers.
+func isRun(cards []Card, aceWrap AceWrap) bool {
+	if len(cards) < 3 {
+		return false
+	}
+	numbers := make([]int, len(cards))
+	for i, card := range cards {
+		if card.Suit != cards[0].Suit {
+			return false
+		}
+		numbers[i] = card.Number
+	}
+	sort.Ints(numbers)
+
+	gaps := []int{}
+	for i := 1; i < len(numbers); i++ {
+		if numbers[i] != numbers[i-1]+1 {
+			gaps = append(gaps, i)
+		}
+	}
+	if len(gaps) == 0 {
+		return true
+	}
+
+	// A wrapped run, e.g. 1-2 and 11-12, has a single gap and spans from the ace to the king.
+	if aceWrap == AceWrapNone || len(gaps) > 1 || numbers[0] != 1 || numbers[len(numbers)-1] != 12 {
+		return false
+	}
+	lowCards := gaps[0]
+	return aceWrap == AceWrapHighAllowed || lowCards >= 2
+}
+
+// wrappedRuns returns the runs in sortedCards (of a single suit, sorted by number) that wrap
+// around from the king to the ace under the ace wrap rule, e.g. 11-12-1, ordered from their
+// highest to their lowest number.
+func wrappedRuns(sortedCards []Card, aceWrap AceWrap) [][]Card {
+	if aceWrap == AceWrapNone || len(sortedCards) < 3 ||
+		sortedCards[0].Number != 1 || sortedCards[len(sortedCards)-1].Number != 12 {
+		return nil
+	}
+
+	// The low cards are consecutive from the ace, and the high cards are consecutive up to the king.
+	low := 1
+	for low < len(sortedCards) && sortedCards[low].Number == sortedCards[low-1].Number+1 {
+		low++
+	}
+	high := 1
+	for high < len(sortedCards)-low && sortedCards[len(sortedCards)-1-high].Number == sortedCards[len(sortedCards)-high].Number-1 {
+		high++
+	}
+
+	minLow := 1
+	if aceWrap == AceWrapLowOnly {
+		minLow = 2
+	}
+	runs := [][]Card{}
+	for h := high; h >= 1; h-- {
+		for l := low; l >= minLow; l-- {
+			if h+l < 3 {
+				continue
+			}
+			run := append([]Card{}, sortedCards[len(sortedCards)-h:]...)
+			runs = append(runs, append(run, sortedCards[:l]...))
+		}
+	}
+	return runs
+}
@@ -0,0 +1,72 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func oros(numbers ...int) []Card {
+	cards := []Card{}
+	for _, number := range numbers {
+		cards = append(cards, Card{Suit: ORO, Number: number})
+	}
+	return cards
+}
+
+func TestIsRunWithAceWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    []Card
+		expected map[AceWrap]bool
+	}{
+		{name: "ace low", cards: oros(1, 2, 3), expected: map[AceWrap]bool{AceWrapNone: true, AceWrapLowOnly: true, AceWrapHighAllowed: true}},
+		{name: "K-1-2", cards: oros(12, 1, 2), expected: map[AceWrap]bool{AceWrapNone: false, AceWrapLowOnly: true, AceWrapHighAllowed: true}},
+		{name: "Q-K-1", cards: oros(11, 12, 1), expected: map[AceWrap]bool{AceWrapNone: false, AceWrapLowOnly: false, AceWrapHighAllowed: true}},
+		{name: "J-Q-K-1-2", cards: oros(10, 11, 12, 1, 2), expected: map[AceWrap]bool{AceWrapNone: false, AceWrapLowOnly: true, AceWrapHighAllowed: true}},
+		{name: "gap after the wrap", cards: oros(12, 1, 3), expected: map[AceWrap]bool{AceWrapNone: false, AceWrapLowOnly: false, AceWrapHighAllowed: false}},
+		{name: "gap before the wrap", cards: oros(10, 12, 1), expected: map[AceWrap]bool{AceWrapNone: false, AceWrapLowOnly: false, AceWrapHighAllowed: false}},
+		{name: "mixed suits", cards: []Card{{Suit: ORO, Number: 12}, {Suit: COPA, Number: 1}, {Suit: ORO, Number: 2}}, expected: map[AceWrap]bool{AceWrapNone: false, AceWrapLowOnly: false, AceWrapHighAllowed: false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for aceWrap, expected := range tt.expected {
+				require.Equal(t, expected, isRun(tt.cards, aceWrap), "ace wrap %v", aceWrap)
+				meld := &Meld{Type: MeldTypeRun, Cards: tt.cards}
+				require.Equal(t, expected, meld.IsValidWithAceWrap(aceWrap), "ace wrap %v", aceWrap)
+			}
+		})
+	}
+}
+
+func TestKingAceTwoMeld(t *testing.T) {
+	kingAceTwo := oros(12, 1, 2)
+	hand := append(oros(12, 1, 2), Card{Suit: COPA, Number: 5}, Card{Suit: ESPADA, Number: 7}, Card{Suit: BASTO, Number: 4}, Card{Suit: COPA, Number: 3})
+
+	g := New()
+	meldPhase(g, hand)
+	require.ErrorIs(t, g.ValidateMeldCards(g.TurnPlayerID, kingAceTwo, MeldTypeRun), ErrInvalidRun)
+	require.False(t, NewActionMeldCards(kingAceTwo, MeldTypeRun, g.TurnPlayerID).IsPossible(*g))
+	require.False(t, (&Meld{Type: MeldTypeRun, Cards: kingAceTwo}).IsValid())
+
+	g = New(WithAceWrap(AceWrapHighAllowed))
+	meldPhase(g, hand)
+	require.NoError(t, g.ValidateMeldCards(g.TurnPlayerID, kingAceTwo, MeldTypeRun))
+	require.NoError(t, g.RunAction(NewActionMeldCards(kingAceTwo, MeldTypeRun, g.TurnPlayerID)))
+	require.Equal(t, 5+7+4+3, g.calculateDeadwoodPoints(g.Players[g.TurnPlayerID].Hand.cards(), g.Players[g.TurnPlayerID].Melds))
+}
+
+func TestFindConsecutiveRunsWithAceWrap(t *testing.T) {
+	cards := oros(1, 2, 11, 12)
+
+	require.Empty(t, New().findConsecutiveRuns(cards))
+
+	g := New(WithAceWrap(AceWrapLowOnly))
+	require.ElementsMatch(t, [][]Card{oros(11, 12, 1, 2), oros(12, 1, 2)}, g.findConsecutiveRuns(cards))
+
+	g = New(WithAceWrap(AceWrapHighAllowed))
+	require.ElementsMatch(t, [][]Card{oros(11, 12, 1, 2), oros(11, 12, 1), oros(12, 1, 2)}, g.findConsecutiveRuns(cards))
+	_, deadwood := g.bestMeldPartition(cards)
+	require.Zero(t, deadwood)
+}
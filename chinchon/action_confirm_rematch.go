@@ -0,0 +1,28 @@
+package chinchon
+
+// ActionConfirmRematch represents a player agreeing to a rematch once the game has ended. When
+// every player has confirmed, the game starts over as its rematch (see GameState.Rematch).
+type ActionConfirmRematch struct {
+	act
+}
+
+func (a ActionConfirmRematch) IsPossible(g GameState) bool {
+	_, ok := g.Players[a.PlayerID]
+	return ok && g.IsGameEnded && !g.RematchConfirmedPlayerIDs[a.PlayerID]
+}
+
+func (a ActionConfirmRematch) Run(g *GameState) error {
+	if !a.IsPossible(*g) {
+		return ErrActionNotPossible
+	}
+	if g.RematchConfirmedPlayerIDs == nil {
+		g.RematchConfirmedPlayerIDs = map[int]bool{}
+	}
+	g.RematchConfirmedPlayerIDs[a.PlayerID] = true
+	return nil
+}
+
+// YieldsTurn returns false, as players confirm a rematch regardless of whose turn it is.
+func (a ActionConfirmRematch) YieldsTurn(g GameState) bool {
+	return false
+}
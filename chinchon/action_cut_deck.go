@@ -0,0 +1,34 @@
+package chinchon
+
+// ActionCutDeck represents the player leading the round cutting the deck before it's dealt (see
+// CutByOpponent). Position is how many cards go from the top of the deck to the bottom.
+type ActionCutDeck struct {
+	act
+	Position int `json:"position"`
+}
+
+// IsPossible returns true if the player must cut the deck, and the cut leaves at least a card on
+// either side of it.
+func (a *ActionCutDeck) IsPossible(g GameState) bool {
+	return g.TurnPlayerID == a.PlayerID &&
+		g.IsCutPending &&
+		!g.IsRoundFinished &&
+		a.Position >= 1 &&
+		a.Position < len(g.deck.cards)
+}
+
+// Run executes the action of cutting the deck, and deals the round.
+func (a *ActionCutDeck) Run(g *GameState) error {
+	if !a.IsPossible(*g) {
+		return ErrActionNotPossible
+	}
+	g.IsCutPending = false
+	g.cutDeck(a.Position)
+	g.dealRound()
+	return nil
+}
+
+// YieldsTurn returns false, as the player who cuts the deck plays the first turn.
+func (a *ActionCutDeck) YieldsTurn(g GameState) bool {
+	return false
+}
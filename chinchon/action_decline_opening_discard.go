@@ -0,0 +1,32 @@
+package chinchon
+
+// ActionDeclineOpeningDiscard represents a player who doesn't lead the round turning down the
+// opening discard (see OpeningDiscardOfferToBoth), which passes the offer to the next player round
+// the table, or the first turn to the leader once every other player declined it.
+type ActionDeclineOpeningDiscard struct {
+	act
+}
+
+// IsPossible returns true if the opening discard is being offered to the player.
+func (a *ActionDeclineOpeningDiscard) IsPossible(g GameState) bool {
+	return g.TurnPlayerID == a.PlayerID &&
+		g.IsOpeningDiscardOffered &&
+		!g.IsRoundFinished
+}
+
+// Run executes the action of declining the opening discard. The turn change itself happens in
+// RunAction.
+func (a *ActionDeclineOpeningDiscard) Run(g *GameState) error {
+	if !a.IsPossible(*g) {
+		return ErrActionNotPossible
+	}
+	g.IsOpeningDiscardOffered = g.OpponentOf(a.PlayerID) != g.RoundLeaderPlayerID
+
+	// The offer isn't a turn of its own: the leader's turn that follows is still the first one.
+	g.RoundTurnNumber--
+	return nil
+}
+
+func (a *ActionDeclineOpeningDiscard) YieldsTurn(g GameState) bool {
+	return true
+}
@@ -9,14 +9,20 @@ type ActionDiscardCard struct {
 }
 
 // IsPossible returns true if the player can discard the specified card.
-// This is possible after drawing and if the card is in their hand.
+// This is possible after drawing and if the card is in their hand, unless they just took it from
+// the discard pile and have any other card to discard instead.
 func (a *ActionDiscardCard) IsPossible(g GameState) bool {
 	if g.TurnPlayerID != a.PlayerID || !g.HasDrawnThisTurn || g.HasDiscardedThisTurn || g.IsRoundFinished {
 		return false
 	}
 
+	hand := g.Players[a.PlayerID].Hand.cards()
+	if g.drewFromDiscardCard != nil && *g.drewFromDiscardCard == a.Card && len(hand) > 1 {
+		return false
+	}
+
 	// Check if the card is in the player's hand
-	for _, card := range g.Players[a.PlayerID].Hand.Revealed {
+	for _, card := range hand {
 		if card == a.Card {
 			return true
 		}
@@ -27,12 +33,12 @@ func (a *ActionDiscardCard) IsPossible(g GameState) bool {
 // Run executes the action of discarding the card.
 func (a *ActionDiscardCard) Run(g *GameState) error {
 	if !a.IsPossible(*g) {
-		return errActionNotPossible
+		return ErrActionNotPossible
 	}
 
 	// Remove the card from the player's hand
 	newHand := []Card{}
-	for _, card := range g.Players[a.PlayerID].Hand.Revealed {
+	for _, card := range g.Players[a.PlayerID].Hand.cards() {
 		if card != a.Card {
 			newHand = append(newHand, card)
 		}
@@ -46,8 +52,21 @@ func (a *ActionDiscardCard) Run(g *GameState) error {
 	return nil
 }
 
+// YieldsTurn returns true unless, after discarding, the player can still knock, meld or lay off. In
+// that case the player keeps the turn, and may do so or end their turn.
 func (a *ActionDiscardCard) YieldsTurn(g GameState) bool {
-	return true // Discarding ends the turn
+	if NewActionKnock(a.PlayerID).IsPossible(g) {
+		return false
+	}
+	if len(g.generatePossibleMeldActions(a.PlayerID)) > 0 {
+		return false
+	}
+	for _, action := range g.generateLayOffActions(a.PlayerID) {
+		if action.IsPossible(g) {
+			return false
+		}
+	}
+	return true
 }
 
 func (a *ActionDiscardCard) String() string {
@@ -0,0 +1,46 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopDiscardCantBeDiscardedStraightBack(t *testing.T) {
+	gameState := New()
+	player := gameState.TurnPlayerID
+	taken, err := gameState.DiscardPile.TopCard()
+	require.NoError(t, err)
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDiscardPile(player)))
+	require.False(t, NewActionDiscardCard(taken, player).IsPossible(*gameState))
+	for _, action := range gameState.CalculatePossibleActions() {
+		require.NotEqual(t, NewActionDiscardCard(taken, player), action)
+	}
+
+	// The restriction survives persisting the game.
+	bs, err := gameState.Serialize()
+	require.NoError(t, err)
+	restored, err := Deserialize(bs)
+	require.NoError(t, err)
+	require.False(t, NewActionDiscardCard(taken, player).IsPossible(*restored))
+
+	discardAndEndTurn(t, gameState, gameState.Players[player].Hand.Revealed[0])
+
+	// On the player's next turn, the card they took can be discarded like any other.
+	opponent := gameState.TurnPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(opponent)))
+	discardAndEndTurn(t, gameState, gameState.Players[opponent].Hand.Revealed[0])
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(player)))
+	require.True(t, NewActionDiscardCard(taken, player).IsPossible(*gameState))
+}
+
+func TestCardDrawnFromTheDrawPileCanBeDiscardedStraightBack(t *testing.T) {
+	gameState := New()
+	player := gameState.TurnPlayerID
+	drawn, err := gameState.DrawPile.TopCard()
+	require.NoError(t, err)
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(player)))
+	require.True(t, NewActionDiscardCard(drawn, player).IsPossible(*gameState))
+}
@@ -6,18 +6,21 @@ type ActionDrawFromDrawPile struct {
 }
 
 // IsPossible returns true if the player can draw from the draw pile.
-// This is possible at the start of their turn if they haven't drawn yet.
+// This is possible at the start of their turn if they haven't drawn yet, once their hand is dealt,
+// unless they're being offered the opening discard.
 func (a *ActionDrawFromDrawPile) IsPossible(g GameState) bool {
 	return g.TurnPlayerID == a.PlayerID &&
 		!g.HasDrawnThisTurn &&
+		!g.IsOpeningDiscardOffered &&
 		!g.DrawPile.IsEmpty() &&
+		g.Players[a.PlayerID].Hand != nil &&
 		!g.IsRoundFinished
 }
 
 // Run executes the action of drawing from the draw pile.
 func (a *ActionDrawFromDrawPile) Run(g *GameState) error {
 	if !a.IsPossible(*g) {
-		return errActionNotPossible
+		return ErrActionNotPossible
 	}
 
 	// Draw the top card from the draw pile
@@ -40,18 +43,31 @@ type ActionDrawFromDiscardPile struct {
 }
 
 // IsPossible returns true if the player can draw from the discard pile.
-// This is possible at the start of their turn if they haven't drawn yet.
+// This is possible at the start of their turn if they haven't drawn yet, once their hand is dealt.
+// Under the strict discard draw rule, the top card must also complete a meld, and the opening
+// discard may be forbidden on the first turn (see WithOpeningDiscardRule).
 func (a *ActionDrawFromDiscardPile) IsPossible(g GameState) bool {
-	return g.TurnPlayerID == a.PlayerID &&
+	if !(g.TurnPlayerID == a.PlayerID &&
 		!g.HasDrawnThisTurn &&
 		!g.DiscardPile.IsEmpty() &&
-		!g.IsRoundFinished
+		g.Players[a.PlayerID].Hand != nil &&
+		!g.IsRoundFinished) {
+		return false
+	}
+	if g.isOpeningDiscardForbidden() {
+		return false
+	}
+	if g.RuleIsStrictDiscardDraw {
+		card, _ := g.DiscardPile.TopCard()
+		return g.completesMeld(a.PlayerID, card)
+	}
+	return true
 }
 
 // Run executes the action of drawing from the discard pile.
 func (a *ActionDrawFromDiscardPile) Run(g *GameState) error {
 	if !a.IsPossible(*g) {
-		return errActionNotPossible
+		return ErrActionNotPossible
 	}
 
 	// Draw the top card from the discard pile
@@ -59,8 +75,12 @@ func (a *ActionDrawFromDiscardPile) Run(g *GameState) error {
 		// Add the card to the player's hand
 		g.Players[a.PlayerID].Hand.Revealed = append(g.Players[a.PlayerID].Hand.Revealed, card)
 		g.HasDrawnThisTurn = true
+		g.drewFromDiscardCard = &card
 	}
 
+	// Taking the opening discard when offered it makes this the round's first turn
+	g.IsOpeningDiscardOffered = false
+
 	return nil
 }
 
@@ -0,0 +1,37 @@
+package chinchon
+
+// ActionEndTurn represents a player ending their turn after discarding, without knocking.
+//
+// It is only needed when the player could still knock or meld after discarding; otherwise the
+// discard ends the turn by itself.
+type ActionEndTurn struct {
+	act
+
+	// DeclinedKnock is true if the player could have knocked instead. It's set when the action
+	// runs (see WithDeclinedKnockPenalty).
+	DeclinedKnock bool `json:"declinedKnock,omitempty"`
+}
+
+// IsPossible returns true if the player has drawn and discarded this turn.
+func (a *ActionEndTurn) IsPossible(g GameState) bool {
+	return g.TurnPlayerID == a.PlayerID &&
+		g.HasDrawnThisTurn &&
+		g.HasDiscardedThisTurn &&
+		!g.IsRoundFinished
+}
+
+// Run executes the action of ending the turn. The turn change itself happens in RunAction.
+func (a *ActionEndTurn) Run(g *GameState) error {
+	if !a.IsPossible(*g) {
+		return ErrActionNotPossible
+	}
+	a.DeclinedKnock = NewActionKnock(a.PlayerID).IsPossible(*g)
+	if a.DeclinedKnock {
+		g.recordDeclinedKnock(a.PlayerID)
+	}
+	return nil
+}
+
+func (a *ActionEndTurn) YieldsTurn(g GameState) bool {
+	return true
+}
@@ -0,0 +1,43 @@
+package chinchon
+
+// ActionForfeit represents a player conceding the game, e.g. so that the server can resolve a game
+// abandoned by a disconnected player.
+type ActionForfeit struct {
+	act
+}
+
+// IsPossible returns true while the game hasn't ended. Any player may forfeit, whether it's their
+// turn or not, although possible actions only offer it to the turn player.
+func (a *ActionForfeit) IsPossible(g GameState) bool {
+	_, ok := g.Players[a.PlayerID]
+	return ok && !g.IsGameEnded
+}
+
+// Run executes the action of forfeiting, which ends the game. The winner is the other player, or
+// with more than two players, the one closest to winning, ties going to the first seated after the
+// player who forfeits.
+func (a *ActionForfeit) Run(g *GameState) error {
+	if !a.IsPossible(*g) {
+		return ErrActionNotPossible
+	}
+	winnerPlayerID := -1
+	for _, playerID := range g.seatingFrom(a.PlayerID)[1:] {
+		if winnerPlayerID == -1 || g.PointsToWin(playerID) < g.PointsToWin(winnerPlayerID) {
+			winnerPlayerID = playerID
+		}
+	}
+	g.IsGameEnded = true
+	g.WinnerPlayerID = winnerPlayerID
+	return nil
+}
+
+// YieldsTurn returns false, as the game is over.
+func (a *ActionForfeit) YieldsTurn(g GameState) bool {
+	return false
+}
+
+// isForfeit returns true if the action is a forfeit, which is always possible, so it doesn't count
+// when working out whether a player has anything left to do.
+func isForfeit(action Action) bool {
+	return action.GetName() == FORFEIT
+}
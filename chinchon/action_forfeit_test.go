@@ -0,0 +1,98 @@
+package chinchon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForfeitEndsTheGame(t *testing.T) {
+	tests := []struct {
+		name               string
+		forfeitsTurnPlayer bool
+	}{
+		{name: "turn_player_forfeits", forfeitsTurnPlayer: true},
+		{name: "opponent_forfeits", forfeitsTurnPlayer: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New()
+			turnPlayerID, opponentID := g.TurnPlayerID, g.TurnOpponentPlayerID
+			require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(turnPlayerID)))
+
+			forfeiterID, expectedWinnerID := opponentID, turnPlayerID
+			if tt.forfeitsTurnPlayer {
+				forfeiterID, expectedWinnerID = turnPlayerID, opponentID
+			}
+			require.NoError(t, g.RunAction(NewActionForfeit(forfeiterID)))
+
+			actionsLog := g.RoundsLog[1].ActionsLog
+			require.Equal(t, forfeiterID, actionsLog[len(actionsLog)-1].PlayerID)
+			require.True(t, g.IsGameEnded)
+			require.Equal(t, expectedWinnerID, g.WinnerPlayerID)
+			for _, action := range g.CalculatePossibleActions() {
+				require.Equal(t, CONFIRM_REMATCH, action.GetName())
+			}
+			require.ErrorIs(t, g.RunAction(NewActionDiscardCard(g.Players[turnPlayerID].Hand.Revealed[0], turnPlayerID)), ErrGameIsEnded)
+			require.ErrorIs(t, g.RunAction(NewActionForfeit(expectedWinnerID)), ErrGameIsEnded)
+			require.NotNil(t, g.RoundsLog[1].DeckOrder)
+		})
+	}
+}
+
+func TestForfeitIsOfferedToTheTurnPlayer(t *testing.T) {
+	g := New()
+
+	forfeits := []int{}
+	for _, action := range g.CalculatePossibleActions() {
+		if isForfeit(action) {
+			forfeits = append(forfeits, action.GetPlayerID())
+		}
+	}
+	require.Equal(t, []int{g.TurnPlayerID}, forfeits)
+
+	knockWinningRound(t, g)
+	for _, action := range g.CalculatePossibleActions() {
+		require.False(t, isForfeit(action), "forfeit isn't offered between rounds")
+	}
+	require.NoError(t, g.RunAction(NewActionForfeit(g.TurnOpponentPlayerID)))
+	require.True(t, g.IsGameEnded)
+}
+
+func TestForfeitWithMorePlayersGoesToTheClosestToWinning(t *testing.T) {
+	g := New(WithPlayers(4), WithStartingScores(map[int]int{0: 20, 2: 30, 3: 30}))
+
+	require.NoError(t, g.RunAction(NewActionForfeit(0)))
+
+	require.Equal(t, 2, g.WinnerPlayerID)
+}
+
+func TestForfeitSurvivesNotation(t *testing.T) {
+	actionsLog := []ActionLog{{PlayerID: 1, Action: SerializeAction(NewActionForfeit(1))}}
+
+	notation, err := EncodeActionsLog(actionsLog)
+	require.NoError(t, err)
+	require.Equal(t, "1F", notation)
+
+	decoded, err := DecodeActionsLog(notation)
+	require.NoError(t, err)
+	require.Equal(t, actionsLog, decoded)
+}
+
+func TestReplayGameReproducesForfeitedGames(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	g := New(WithSeed(3))
+	for g.RoundNumber < 2 {
+		require.NoError(t, g.RunAction(randomAction(rng, g)))
+	}
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+	require.NoError(t, g.RunAction(NewActionForfeit(g.TurnOpponentPlayerID)))
+
+	replayed, err := ReplayGame(g.RoundsLog, WithSeed(3))
+	require.NoError(t, err)
+	require.True(t, replayed.IsGameEnded)
+	require.Equal(t, g.WinnerPlayerID, replayed.WinnerPlayerID)
+	require.Equal(t, g.RoundsLog, replayed.RoundsLog)
+}
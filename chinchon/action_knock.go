@@ -14,37 +14,34 @@ func (a *ActionKnock) IsPossible(g GameState) bool {
 		return false
 	}
 
-	// Check if the player has valid melds that leave minimal deadwood
-	return a.hasValidMelds(g)
+	if g.RuleNoFirstTurnKnock && g.RoundTurnNumber == 1 {
+		return false
+	}
+
+	// Check if the player has valid melds that leave minimal deadwood, or holds a chinchón, which
+	// needn't be melded
+	return a.hasValidMelds(g) || g.isChinchon(a.PlayerID)
 }
 
-// hasValidMelds checks if the player has 10 or fewer deadwood points (can knock).
+// hasValidMelds checks if the player's deadwood points are within the knock threshold (can knock).
+// Cards that form melds count as melded, even if the player hasn't laid them down (see
+// BestDeadwood).
 func (a *ActionKnock) hasValidMelds(g GameState) bool {
-	deadwood := calculateDeadwoodPoints(g.Players[a.PlayerID].Hand.Revealed, g.Players[a.PlayerID].Melds)
-	return deadwood <= 10
+	return g.BestDeadwood(a.PlayerID) <= g.RuleKnockThreshold
 }
 
 // Run executes the action of knocking.
 func (a *ActionKnock) Run(g *GameState) error {
 	if !a.IsPossible(*g) {
-		return errActionNotPossible
+		return ErrActionNotPossible
 	}
 
 	g.KnockedPlayerID = a.PlayerID
-
-	// Calculate round scores
-	g.calculateRoundScore()
-
-	// Update round log with melds
 	roundLog := g.RoundsLog[g.RoundNumber]
+	// Scoring needs to know who knocked, for undercuts and ties
 	roundLog.KnockedPlayerID = a.PlayerID
-	roundLog.MeldsDealt = map[int][]*Meld{
-		0: append([]*Meld(nil), g.Players[0].Melds...),
-		1: append([]*Meld(nil), g.Players[1].Melds...),
-	}
-
-	g.IsRoundFinished = true
 
+	g.finishRound()
 	return nil
 }
 
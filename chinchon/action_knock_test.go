@@ -0,0 +1,168 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readyToKnock sets up the turn player as having drawn and discarded, holding two sets and 7
+// deadwood points.
+func readyToKnock(g *GameState) {
+	g.Players[g.TurnPlayerID].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1},
+		{Suit: ORO, Number: 2}, {Suit: COPA, Number: 2}, {Suit: ESPADA, Number: 2},
+		{Suit: BASTO, Number: 7},
+	}
+	g.Players[g.TurnPlayerID].Melds = []*Meld{}
+	g.HasDrawnThisTurn = true
+	g.HasDiscardedThisTurn = true
+}
+
+func TestNoFirstTurnKnock(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []func(*GameState)
+		roundTurnNumber int
+		expected        bool
+	}{
+		{name: "enabled_blocks_first_turn", opts: []func(*GameState){WithNoFirstTurnKnock(true)}, roundTurnNumber: 1, expected: false},
+		{name: "enabled_allows_second_turn", opts: []func(*GameState){WithNoFirstTurnKnock(true)}, roundTurnNumber: 2, expected: true},
+		{name: "disabled_allows_first_turn", opts: []func(*GameState){WithNoFirstTurnKnock(false)}, roundTurnNumber: 1, expected: true},
+		{name: "default_allows_first_turn", roundTurnNumber: 1, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+			readyToKnock(gameState)
+			gameState.RoundTurnNumber = tt.roundTurnNumber
+
+			require.Equal(t, tt.expected, NewActionKnock(gameState.TurnPlayerID).IsPossible(*gameState))
+		})
+	}
+}
+
+func TestNoFirstTurnKnockAfterDeclinedOpeningDiscard(t *testing.T) {
+	gameState := New(WithNoFirstTurnKnock(true), WithOpeningDiscardRule(OpeningDiscardOfferToBoth))
+	require.NoError(t, gameState.RunAction(NewActionDeclineOpeningDiscard(gameState.TurnPlayerID)))
+
+	// Declining the opening discard is logged, but the leader's turn is still the round's first.
+	require.Len(t, gameState.RoundsLog[gameState.RoundNumber].ActionsLog, 1)
+	readyToKnock(gameState)
+	require.False(t, NewActionKnock(gameState.TurnPlayerID).IsPossible(*gameState))
+
+	gameState.HasDrawnThisTurn = false
+	gameState.HasDiscardedThisTurn = false
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(gameState.TurnPlayerID)))
+	discardAndEndTurn(t, gameState, gameState.Players[gameState.TurnPlayerID].Hand.Revealed[0])
+
+	readyToKnock(gameState)
+	require.True(t, NewActionKnock(gameState.TurnPlayerID).IsPossible(*gameState))
+}
+
+func TestRoundTurnNumberAdvancesWithTurns(t *testing.T) {
+	gameState := New()
+	require.Equal(t, 1, gameState.RoundTurnNumber)
+
+	firstPlayerID := gameState.TurnPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(firstPlayerID)))
+	require.Equal(t, 1, gameState.RoundTurnNumber)
+
+	// Discard the drawn card, and end the turn if the player could still knock or meld.
+	hand := gameState.Players[firstPlayerID].Hand.Revealed
+	require.NoError(t, gameState.RunAction(NewActionDiscardCard(hand[len(hand)-1], firstPlayerID)))
+	if gameState.TurnPlayerID == firstPlayerID {
+		require.NoError(t, gameState.RunAction(NewActionEndTurn(firstPlayerID)))
+	}
+
+	require.Equal(t, 2, gameState.RoundTurnNumber)
+	require.NotEqual(t, firstPlayerID, gameState.TurnPlayerID)
+}
+
+func TestKnockCountsUnmeldedMeldsOptimally(t *testing.T) {
+	tests := []struct {
+		name     string
+		hand     []Card
+		deadwood int
+		canKnock bool
+	}{
+		{
+			// Melding the long run first would leave the 7s unmelded: 15 points.
+			name: "short_run_and_set_beat_long_run",
+			hand: []Card{
+				{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7},
+				{Suit: COPA, Number: 7}, {Suit: BASTO, Number: 7}, {Suit: ESPADA, Number: 1},
+			},
+			deadwood: 1,
+			canKnock: true,
+		},
+		{
+			// Melding the set of 7s first would leave the 5s and 6s unmelded: 22 points.
+			name: "two_runs_beat_set",
+			hand: []Card{
+				{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: BASTO, Number: 7},
+				{Suit: COPA, Number: 5}, {Suit: COPA, Number: 6}, {Suit: BASTO, Number: 5}, {Suit: BASTO, Number: 6},
+			},
+			deadwood: 7,
+			canKnock: true,
+		},
+		{
+			name: "too_much_deadwood_whatever_the_arrangement",
+			hand: []Card{
+				{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7},
+				{Suit: COPA, Number: 7}, {Suit: BASTO, Number: 4}, {Suit: ESPADA, Number: 1},
+			},
+			deadwood: 12,
+			canKnock: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New()
+			knocker := gameState.TurnPlayerID
+			readyToKnock(gameState)
+			gameState.Players[knocker].Hand.Revealed = tt.hand
+
+			gameState.Players[gameState.TurnOpponentPlayerID].Hand.Revealed = []Card{
+				{Suit: ORO, Number: 10}, {Suit: COPA, Number: 11}, {Suit: ESPADA, Number: 12}, {Suit: BASTO, Number: 10},
+				{Suit: ORO, Number: 11}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 3},
+			}
+
+			require.Equal(t, tt.canKnock, NewActionKnock(knocker).IsPossible(*gameState))
+			if !tt.canKnock {
+				return
+			}
+			require.NoError(t, gameState.RunAction(NewActionKnock(knocker)))
+			roundLog := gameState.RoundsLog[gameState.RoundNumber]
+			require.Equal(t, knocker, roundLog.WinnerPlayerID)
+			require.Equal(t, tt.deadwood, roundLog.WinnerDeadwoodPoints)
+			require.Equal(t, 63-tt.deadwood, roundLog.PointsAwarded)
+		})
+	}
+}
+
+func TestKnockThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []func(*GameState)
+		expected bool
+	}{
+		{name: "default_threshold", expected: true},
+		{name: "threshold_10", opts: []func(*GameState){WithKnockThreshold(10)}, expected: true},
+		{name: "threshold_7", opts: []func(*GameState){WithKnockThreshold(7)}, expected: true},
+		{name: "threshold_5", opts: []func(*GameState){WithKnockThreshold(5)}, expected: false},
+		{name: "gin_only", opts: []func(*GameState){WithKnockThreshold(0)}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+			readyToKnock(gameState)
+			require.Equal(t, 7, gameState.BestDeadwood(gameState.TurnPlayerID))
+
+			require.Equal(t, tt.expected, NewActionKnock(gameState.TurnPlayerID).IsPossible(*gameState))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package chinchon
+
+import "fmt"
+
+// ActionLayOffCard represents adding a card from the hand to a meld already on the table, e.g. the
+// 8 de oro onto a 5-6-7 de oro run.
+type ActionLayOffCard struct {
+	act
+	Card Card `json:"card"`
+
+	// MeldPlayerID and MeldIndex identify the target meld: the index into the melds of that player,
+	// who may be the opponent.
+	MeldPlayerID int `json:"meldPlayerID"`
+	MeldIndex    int `json:"meldIndex"`
+}
+
+// IsPossible returns true if the player can lay off the card.
+// This is possible after drawing and discarding, if the card is in the player's hand and the target
+// meld stays valid with the card added to it.
+func (a *ActionLayOffCard) IsPossible(g GameState) bool {
+	if g.TurnPlayerID != a.PlayerID || !g.HasDrawnThisTurn || !g.HasDiscardedThisTurn || g.IsRoundFinished {
+		return false
+	}
+	if !containsCard(g.Players[a.PlayerID].Hand.cards(), a.Card) {
+		return false
+	}
+	target := a.targetMeld(g)
+	if target == nil {
+		return false
+	}
+	return a.extend(target).IsValidWithAceWrap(g.RuleAceWrap)
+}
+
+// targetMeld returns the meld to lay off the card onto, or nil if it doesn't exist.
+func (a *ActionLayOffCard) targetMeld(g GameState) *Meld {
+	player, ok := g.Players[a.MeldPlayerID]
+	if !ok || a.MeldIndex < 0 || a.MeldIndex >= len(player.Melds) {
+		return nil
+	}
+	return player.Melds[a.MeldIndex]
+}
+
+// extend returns a copy of the meld with the card added to it.
+func (a *ActionLayOffCard) extend(meld *Meld) *Meld {
+	return &Meld{Type: meld.Type, Cards: append(append([]Card{}, meld.Cards...), a.Card)}
+}
+
+// Run executes the action of laying off the card: it leaves the hand, and joins the target meld.
+func (a *ActionLayOffCard) Run(g *GameState) error {
+	if !a.IsPossible(*g) {
+		return ErrActionNotPossible
+	}
+
+	g.Players[a.PlayerID].Hand.Revealed = without(g.Players[a.PlayerID].Hand.cards(), a.Card)
+	g.Players[a.MeldPlayerID].Melds[a.MeldIndex] = a.extend(a.targetMeld(*g))
+
+	return nil
+}
+
+func (a *ActionLayOffCard) YieldsTurn(g GameState) bool {
+	return false // Laying off doesn't end the turn
+}
+
+func (a *ActionLayOffCard) String() string {
+	return fmt.Sprintf("Player %v lays off %v onto meld %v of player %v", a.PlayerID, a.Card, a.MeldIndex, a.MeldPlayerID)
+}
+
+// generateLayOffActions generates an action for every card in the player's hand and every meld on
+// the table; CalculatePossibleActions filters out those that aren't possible.
+func (g GameState) generateLayOffActions(playerID int) []Action {
+	actions := []Action{}
+	for _, card := range g.Players[playerID].Hand.cards() {
+		for _, meldPlayerID := range g.PlayerOrder {
+			for meldIndex := range g.Players[meldPlayerID].Melds {
+				actions = append(actions, NewActionLayOffCard(card, meldPlayerID, meldIndex, playerID))
+			}
+		}
+	}
+	return actions
+}
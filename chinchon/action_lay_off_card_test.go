@@ -0,0 +1,91 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// layOffState is a state where the turn player has drawn and discarded, holding the 7 de oro, the
+// 3 de basto and the 2 de copa, with a 4-6 de oro run on the table, and the opponent has a set of
+// 3s on the table.
+func layOffState() *GameState {
+	g := New()
+	g.Players[g.TurnPlayerID].Hand.Revealed = []Card{{Suit: ORO, Number: 7}, {Suit: BASTO, Number: 3}, {Suit: COPA, Number: 2}}
+	g.Players[g.TurnPlayerID].Melds = []*Meld{{Type: MeldTypeRun, Cards: oros(4, 5, 6)}}
+	g.Players[g.TurnOpponentPlayerID].Melds = []*Meld{{Type: MeldTypeSet, Cards: []Card{{Suit: ORO, Number: 3}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 3}}}}
+	g.HasDrawnThisTurn = true
+	g.HasDiscardedThisTurn = true
+	return g
+}
+
+func TestLayOffCardIsPossible(t *testing.T) {
+	g := layOffState()
+	playerID, opponentID := g.TurnPlayerID, g.TurnOpponentPlayerID
+
+	tests := []struct {
+		name     string
+		action   Action
+		expected bool
+	}{
+		{name: "extends_own_run", action: NewActionLayOffCard(Card{Suit: ORO, Number: 7}, playerID, 0, playerID), expected: true},
+		{name: "extends_opponent_set", action: NewActionLayOffCard(Card{Suit: BASTO, Number: 3}, opponentID, 0, playerID), expected: true},
+		{name: "breaks_the_meld", action: NewActionLayOffCard(Card{Suit: COPA, Number: 2}, playerID, 0, playerID), expected: false},
+		{name: "card_not_in_hand", action: NewActionLayOffCard(Card{Suit: ORO, Number: 3}, playerID, 0, playerID), expected: false},
+		{name: "missing_meld", action: NewActionLayOffCard(Card{Suit: ORO, Number: 7}, playerID, 1, playerID), expected: false},
+		{name: "not_their_turn", action: NewActionLayOffCard(Card{Suit: BASTO, Number: 3}, opponentID, 0, opponentID), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.action.IsPossible(*g))
+		})
+	}
+
+	g.HasDiscardedThisTurn = false
+	require.False(t, NewActionLayOffCard(Card{Suit: ORO, Number: 7}, playerID, 0, playerID).IsPossible(*g), "lay-offs come after discarding")
+}
+
+func TestLayOffCardRun(t *testing.T) {
+	g := layOffState()
+	playerID := g.TurnPlayerID
+	g.PossibleActions = _serializeActions(g.CalculatePossibleActions())
+	require.Contains(t, g.PossibleActions, json.RawMessage(SerializeAction(NewActionLayOffCard(Card{Suit: ORO, Number: 7}, playerID, 0, playerID))))
+	require.Equal(t, 12, g.calculateDeadwoodPoints(g.Players[playerID].Hand.cards(), g.Players[playerID].Melds))
+
+	require.NoError(t, g.RunAction(NewActionLayOffCard(Card{Suit: ORO, Number: 7}, playerID, 0, playerID)))
+
+	require.Equal(t, []Card{{Suit: BASTO, Number: 3}, {Suit: COPA, Number: 2}}, g.Players[playerID].Hand.Revealed)
+	require.Equal(t, oros(4, 5, 6, 7), g.Players[playerID].Melds[0].Cards)
+	require.Equal(t, 5, g.calculateDeadwoodPoints(g.Players[playerID].Hand.cards(), g.Players[playerID].Melds))
+	require.Equal(t, playerID, g.TurnPlayerID, "laying off doesn't end the turn")
+}
+
+func TestLayOffCardSerialization(t *testing.T) {
+	action := NewActionLayOffCard(Card{Suit: BASTO, Number: 3}, 1, 0, 0)
+
+	deserialized, err := DeserializeAction(SerializeAction(action))
+	require.NoError(t, err)
+	require.Equal(t, action, deserialized)
+
+	notation, err := EncodeActionsLog([]ActionLog{{PlayerID: 0, Action: SerializeAction(action)}})
+	require.NoError(t, err)
+	require.Equal(t, "0L3b@1.0", notation)
+	decoded, err := DecodeActionsLog(notation)
+	require.NoError(t, err)
+	require.Equal(t, SerializeAction(action), []byte(decoded[0].Action))
+}
+
+func TestLayOffIsOfferedAfterDiscardingWhenItIsTheOnlyOption(t *testing.T) {
+	g := layOffState()
+	playerID := g.TurnPlayerID
+	g.Players[playerID].Hand.Revealed = append(g.Players[playerID].Hand.Revealed, Card{Suit: ESPADA, Number: 12})
+	g.HasDiscardedThisTurn = false
+
+	require.NoError(t, g.RunAction(NewActionDiscardCard(Card{Suit: ESPADA, Number: 12}, playerID)))
+
+	require.Equal(t, playerID, g.TurnPlayerID, "the player keeps the turn to lay off")
+	require.False(t, NewActionKnock(playerID).IsPossible(*g))
+	require.Contains(t, g.PossibleActions, json.RawMessage(SerializeAction(NewActionLayOffCard(Card{Suit: ORO, Number: 7}, playerID, 0, playerID))))
+}
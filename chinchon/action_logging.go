@@ -0,0 +1,39 @@
+package chinchon
+
+// ActionLoggingLevel controls which actions are appended to each round's ActionsLog.
+type ActionLoggingLevel int
+
+const (
+	// ActionLoggingAll logs every action except round finished and rematch confirmations. It's the
+	// default.
+	ActionLoggingAll ActionLoggingLevel = iota
+
+	// ActionLoggingMeldsAndKnocks only logs melds (including lay-offs), knocks and forfeits.
+	ActionLoggingMeldsAndKnocks
+
+	// ActionLoggingNone logs no actions.
+	ActionLoggingNone
+)
+
+// WithActionLogging sets which actions are logged in each round's ActionsLog.
+//
+// Lower levels save memory on servers hosting many games, at a cost: rounds can no longer be
+// replayed or compacted faithfully, and everything derived from the log only sees the logged
+// actions. That includes SeenCards, KnockPreview and LastActionLog in ClientGameState.
+func WithActionLogging(level ActionLoggingLevel) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleActionLogging = level
+	}
+}
+
+// shouldLogAction returns true if the action must be appended to the round's ActionsLog.
+func (g GameState) shouldLogAction(action Action) bool {
+	switch action.GetName() {
+	case CONFIRM_ROUND_FINISHED, CONFIRM_REMATCH:
+		return false
+	case MELD_CARDS, LAY_OFF_CARD, KNOCK, FORFEIT:
+		return g.RuleActionLogging != ActionLoggingNone
+	default:
+		return g.RuleActionLogging == ActionLoggingAll
+	}
+}
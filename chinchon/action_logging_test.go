@@ -0,0 +1,63 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionLogging(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []func(*GameState)
+		expected []string
+	}{
+		{
+			name:     "default logs everything",
+			expected: []string{DRAW_FROM_DRAW_PILE, DISCARD_CARD, MELD_CARDS, KNOCK},
+		},
+		{
+			name:     "all",
+			opts:     []func(*GameState){WithActionLogging(ActionLoggingAll)},
+			expected: []string{DRAW_FROM_DRAW_PILE, DISCARD_CARD, MELD_CARDS, KNOCK},
+		},
+		{
+			name:     "melds and knocks",
+			opts:     []func(*GameState){WithActionLogging(ActionLoggingMeldsAndKnocks)},
+			expected: []string{MELD_CARDS, KNOCK},
+		},
+		{
+			name:     "none",
+			opts:     []func(*GameState){WithActionLogging(ActionLoggingNone)},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New(tt.opts...)
+			you, them := g.TurnPlayerID, g.TurnOpponentPlayerID
+			round := g.RoundNumber
+
+			require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(you)))
+			discard := Card{Suit: BASTO, Number: 12}
+			g.Players[you].Hand.Revealed = []Card{
+				{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}, {Suit: BASTO, Number: 1},
+				{Suit: ORO, Number: 2}, {Suit: COPA, Number: 2}, {Suit: ESPADA, Number: 2}, discard,
+			}
+			require.NoError(t, g.RunAction(NewActionDiscardCard(discard, you)))
+			require.NoError(t, g.RunAction(NewActionMeldCards([]Card{{Suit: ORO, Number: 2}, {Suit: COPA, Number: 2}, {Suit: ESPADA, Number: 2}}, MeldTypeSet, you)))
+			require.NoError(t, g.RunAction(NewActionKnock(you)))
+			require.True(t, g.IsRoundFinished)
+			require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(them)))
+
+			actual := []string{}
+			for _, log := range g.RoundsLog[round].ActionsLog {
+				action, err := DeserializeAction(log.Action)
+				require.NoError(t, err)
+				actual = append(actual, action.GetName())
+			}
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package chinchon
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // ActionMeldCards represents melding cards into a valid combination.
 type ActionMeldCards struct {
@@ -10,30 +13,42 @@ type ActionMeldCards struct {
 }
 
 // IsPossible returns true if the player can meld the specified cards.
-// This is possible if the cards form a valid set or run and are in the player's hand.
+// This is possible if the cards form a valid set or run and are in the player's hand (see
+// GameState.ValidateMeldCards for why a meld isn't possible).
+//
+// If the cards form a valid meld of the other type than the declared one (e.g. a run labeled as a
+// set), the meld is still possible: Run corrects the type. Cards can never form both a valid set
+// and a valid run, since sets require different suits and runs require a single suit.
 func (a *ActionMeldCards) IsPossible(g GameState) bool {
 	if g.TurnPlayerID != a.PlayerID || g.IsRoundFinished {
 		return false
 	}
 
-	// Check if all cards are in the player's hand
-	hand := make(map[Card]bool)
-	for _, card := range g.Players[a.PlayerID].Hand.Revealed {
-		hand[card] = true
-	}
+	err := g.ValidateMeldCards(a.PlayerID, a.Cards, a.MeldType)
+	return err == nil || errors.Is(err, ErrWrongMeldType)
+}
 
-	for _, card := range a.Cards {
-		if !hand[card] {
-			return false
+// correctedMeldType returns the other meld type if the cards form a valid meld of that type but
+// not of the declared one. Otherwise, it returns an empty MeldType.
+func (a *ActionMeldCards) correctedMeldType(aceWrap AceWrap) MeldType {
+	if a.isValidMeld(aceWrap) {
+		return ""
+	}
+	switch a.MeldType {
+	case MeldTypeSet:
+		if a.isValidRun(aceWrap) {
+			return MeldTypeRun
+		}
+	case MeldTypeRun:
+		if a.isValidSet() {
+			return MeldTypeSet
 		}
 	}
-
-	// Check if the cards form a valid meld
-	return a.isValidMeld()
+	return ""
 }
 
 // isValidMeld checks if the cards form a valid meld (set or run).
-func (a *ActionMeldCards) isValidMeld() bool {
+func (a *ActionMeldCards) isValidMeld(aceWrap AceWrap) bool {
 	if len(a.Cards) < 3 {
 		return false
 	}
@@ -41,7 +56,7 @@ func (a *ActionMeldCards) isValidMeld() bool {
 	if a.MeldType == MeldTypeSet {
 		return a.isValidSet()
 	} else if a.MeldType == MeldTypeRun {
-		return a.isValidRun()
+		return a.isValidRun(aceWrap)
 	}
 
 	return false
@@ -72,49 +87,26 @@ func (a *ActionMeldCards) isValidSet() bool {
 	return true
 }
 
-// isValidRun checks if the cards form a valid run (consecutive ranks, same suit).
-func (a *ActionMeldCards) isValidRun() bool {
-	if len(a.Cards) < 3 {
-		return false
-	}
-
-	suit := a.Cards[0].Suit
-	for _, card := range a.Cards {
-		if card.Suit != suit {
-			return false
-		}
-	}
-
-	// Sort cards by number
-	sortedCards := make([]Card, len(a.Cards))
-	copy(sortedCards, a.Cards)
-	for i := 0; i < len(sortedCards)-1; i++ {
-		for j := i + 1; j < len(sortedCards); j++ {
-			if sortedCards[i].Number > sortedCards[j].Number {
-				sortedCards[i], sortedCards[j] = sortedCards[j], sortedCards[i]
-			}
-		}
-	}
-
-	// Check for consecutive numbers
-	for i := 1; i < len(sortedCards); i++ {
-		if sortedCards[i].Number != sortedCards[i-1].Number+1 {
-			return false
-		}
-	}
-
-	return true
+// isValidRun checks if the cards form a valid run (consecutive ranks, same suit), possibly wrapping
+// around from the king to the ace (see WithAceWrap).
+func (a *ActionMeldCards) isValidRun(aceWrap AceWrap) bool {
+	return isRun(a.Cards, aceWrap)
 }
 
 // Run executes the action of melding the cards.
 func (a *ActionMeldCards) Run(g *GameState) error {
 	if !a.IsPossible(*g) {
-		return errActionNotPossible
+		return ErrActionNotPossible
+	}
+
+	// Fix mislabeled melds, so that both the meld and the action log have the right type
+	if meldType := a.correctedMeldType(g.RuleAceWrap); meldType != "" {
+		a.MeldType = meldType
 	}
 
 	// Remove the cards from the player's hand
 	newHand := []Card{}
-	hand := g.Players[a.PlayerID].Hand.Revealed
+	hand := g.Players[a.PlayerID].Hand.cards()
 	for _, card := range hand {
 		found := false
 		for _, meldCard := range a.Cards {
@@ -139,6 +131,10 @@ func (a *ActionMeldCards) Run(g *GameState) error {
 	return nil
 }
 
+func (a *ActionMeldCards) YieldsTurn(g GameState) bool {
+	return false // Melding doesn't end the turn
+}
+
 func (a *ActionMeldCards) String() string {
 	return fmt.Sprintf("Player %v melds %d cards as %s", a.PlayerID, len(a.Cards), a.MeldType)
 }
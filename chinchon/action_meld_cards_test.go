@@ -0,0 +1,90 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// meldPhase sets up the turn player as having drawn and discarded, holding the given hand.
+func meldPhase(g *GameState, hand []Card) {
+	g.Players[g.TurnPlayerID].Hand.Revealed = hand
+	g.Players[g.TurnPlayerID].Melds = []*Meld{}
+	g.HasDrawnThisTurn = true
+	g.HasDiscardedThisTurn = true
+}
+
+func TestNoCardsFormBothASetAndARun(t *testing.T) {
+	g := New()
+	cards := spanishCards(DefaultDeckSize)
+	for _, combo := range g.generateCombinations(cards, 3) {
+		set := NewActionMeldCards(combo, MeldTypeSet, 0).(*ActionMeldCards)
+		run := NewActionMeldCards(combo, MeldTypeRun, 0).(*ActionMeldCards)
+		require.False(t, set.isValidMeld(AceWrapNone) && run.isValidMeld(AceWrapNone), "%v is both a set and a run", combo)
+	}
+}
+
+func TestMislabeledMeldIsCorrected(t *testing.T) {
+	run := []Card{{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}}
+	set := []Card{{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: BASTO, Number: 7}}
+
+	tests := []struct {
+		name         string
+		cards        []Card
+		declaredType MeldType
+		expectedType MeldType
+	}{
+		{name: "run_labeled_as_set", cards: run, declaredType: MeldTypeSet, expectedType: MeldTypeRun},
+		{name: "set_labeled_as_run", cards: set, declaredType: MeldTypeRun, expectedType: MeldTypeSet},
+		{name: "correctly_labeled_run", cards: run, declaredType: MeldTypeRun, expectedType: MeldTypeRun},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New()
+			playerID := gameState.TurnPlayerID
+			meldPhase(gameState, append(append([]Card{}, tt.cards...), Card{Suit: ESPADA, Number: 12}))
+
+			require.NoError(t, gameState.RunAction(NewActionMeldCards(tt.cards, tt.declaredType, playerID)))
+
+			melds := gameState.Players[playerID].Melds
+			require.Len(t, melds, 1)
+			require.Equal(t, tt.expectedType, melds[0].Type)
+
+			loggedAction := _deserializeCurrentRoundLastAction(*gameState).(*ActionMeldCards)
+			require.Equal(t, tt.expectedType, loggedAction.MeldType)
+		})
+	}
+}
+
+func TestInvalidMeldIsNotPossible(t *testing.T) {
+	gameState := New()
+	cards := []Card{{Suit: ORO, Number: 4}, {Suit: COPA, Number: 5}, {Suit: ORO, Number: 6}}
+	meldPhase(gameState, cards)
+
+	require.False(t, NewActionMeldCards(cards, MeldTypeSet, gameState.TurnPlayerID).IsPossible(*gameState))
+	require.False(t, NewActionMeldCards(cards, MeldTypeRun, gameState.TurnPlayerID).IsPossible(*gameState))
+}
+
+func TestCardCannotBeMeldedIntoTwoSets(t *testing.T) {
+	var (
+		oro4    = Card{Suit: ORO, Number: 4}
+		copa4   = Card{Suit: COPA, Number: 4}
+		basto4  = Card{Suit: BASTO, Number: 4}
+		espada4 = Card{Suit: ESPADA, Number: 4}
+	)
+	gameState := New()
+	playerID := gameState.TurnPlayerID
+	meldPhase(gameState, []Card{oro4, copa4, basto4, espada4})
+	require.NoError(t, gameState.RunAction(NewActionMeldCards([]Card{oro4, copa4, basto4}, MeldTypeSet, playerID)))
+
+	secondSet := NewActionMeldCards([]Card{oro4, copa4, espada4}, MeldTypeSet, playerID)
+	require.False(t, secondSet.IsPossible(*gameState))
+
+	// Even if the melded cards were somehow still in the hand, they're committed to the first set.
+	gameState.Players[playerID].Hand.Revealed = []Card{oro4, copa4, espada4}
+	require.False(t, secondSet.IsPossible(*gameState))
+	require.ErrorIs(t, gameState.ValidateMeldCards(playerID, []Card{oro4, copa4, espada4}, MeldTypeSet), ErrCardAlreadyMelded)
+	require.ErrorIs(t, gameState.RunAction(secondSet), ErrActionNotPossible)
+	require.Len(t, gameState.Players[playerID].Melds, 1)
+}
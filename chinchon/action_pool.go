@@ -0,0 +1,115 @@
+package chinchon
+
+import "sync"
+
+// WithActionPool makes the game reuse the actions it builds to work out the possible actions after
+// each action, rather than allocating them anew every time, to cut garbage collection pressure when
+// simulating many games. The game plays out identically either way.
+//
+// Only the actions the game builds and discards internally are pooled: actions returned by
+// CalculatePossibleActions are always freshly allocated, so they're safe to keep.
+func WithActionPool(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.isActionPooled = enabled
+	}
+}
+
+// Pools of the actions offered on most turns. Melds and lay-offs vary too much to be worth pooling.
+var (
+	drawFromDrawPilePool      = sync.Pool{New: func() any { return &ActionDrawFromDrawPile{} }}
+	drawFromDiscardPilePool   = sync.Pool{New: func() any { return &ActionDrawFromDiscardPile{} }}
+	declineOpeningDiscardPool = sync.Pool{New: func() any { return &ActionDeclineOpeningDiscard{} }}
+	discardCardPool           = sync.Pool{New: func() any { return &ActionDiscardCard{} }}
+	knockPool                 = sync.Pool{New: func() any { return &ActionKnock{} }}
+	endTurnPool               = sync.Pool{New: func() any { return &ActionEndTurn{} }}
+)
+
+// actionFactory builds the actions offered on most turns, either from the pools or with the New*
+// constructors.
+type actionFactory struct {
+	isPooled bool
+}
+
+func (f actionFactory) drawFromDrawPile(playerID int) Action {
+	if !f.isPooled {
+		return NewActionDrawFromDrawPile(playerID)
+	}
+	a := drawFromDrawPilePool.Get().(*ActionDrawFromDrawPile)
+	a.act = act{Name: DRAW_FROM_DRAW_PILE, PlayerID: playerID}
+	return a
+}
+
+func (f actionFactory) drawFromDiscardPile(playerID int) Action {
+	if !f.isPooled {
+		return NewActionDrawFromDiscardPile(playerID)
+	}
+	a := drawFromDiscardPilePool.Get().(*ActionDrawFromDiscardPile)
+	a.act = act{Name: DRAW_FROM_DISCARD_PILE, PlayerID: playerID}
+	return a
+}
+
+func (f actionFactory) declineOpeningDiscard(playerID int) Action {
+	if !f.isPooled {
+		return NewActionDeclineOpeningDiscard(playerID)
+	}
+	a := declineOpeningDiscardPool.Get().(*ActionDeclineOpeningDiscard)
+	a.act = act{Name: DECLINE_OPENING_DISCARD, PlayerID: playerID}
+	return a
+}
+
+func (f actionFactory) discardCard(card Card, playerID int) Action {
+	if !f.isPooled {
+		return NewActionDiscardCard(card, playerID)
+	}
+	a := discardCardPool.Get().(*ActionDiscardCard)
+	a.act = act{Name: DISCARD_CARD, PlayerID: playerID}
+	a.Card = card
+	return a
+}
+
+func (f actionFactory) knock(playerID int) Action {
+	if !f.isPooled {
+		return NewActionKnock(playerID)
+	}
+	a := knockPool.Get().(*ActionKnock)
+	a.act = act{Name: KNOCK, PlayerID: playerID}
+	return a
+}
+
+func (f actionFactory) endTurn(playerID int) Action {
+	if !f.isPooled {
+		return NewActionEndTurn(playerID)
+	}
+	a := endTurnPool.Get().(*ActionEndTurn)
+	a.act = act{Name: END_TURN, PlayerID: playerID}
+	return a
+}
+
+// release returns the actions to their pools, if pooled. They must not be used afterwards.
+func (f actionFactory) release(actions ...Action) {
+	if !f.isPooled {
+		return
+	}
+	for _, action := range actions {
+		switch a := action.(type) {
+		case *ActionDrawFromDrawPile:
+			*a = ActionDrawFromDrawPile{}
+			drawFromDrawPilePool.Put(a)
+		case *ActionDrawFromDiscardPile:
+			*a = ActionDrawFromDiscardPile{}
+			drawFromDiscardPilePool.Put(a)
+		case *ActionDeclineOpeningDiscard:
+			*a = ActionDeclineOpeningDiscard{}
+			declineOpeningDiscardPool.Put(a)
+		case *ActionDiscardCard:
+			*a = ActionDiscardCard{}
+			discardCardPool.Put(a)
+		case *ActionKnock:
+			*a = ActionKnock{}
+			knockPool.Put(a)
+		case *ActionEndTurn:
+			*a = ActionEndTurn{}
+			endTurnPool.Put(a)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// playSeededGame plays a random game, seeded for both the deck and the choice of actions.
+func playSeededGame(t testing.TB, seed int64, opts ...func(*GameState)) *GameState {
+	g := New(append([]func(*GameState){WithSeed(seed)}, opts...)...)
+	playRandomGame(t, rand.New(rand.NewSource(seed)), g, 5000, func(map[int]int, Action) {})
+	return g
+}
+
+func TestPooledGamesPlayOutIdentically(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []func(*GameState)
+	}{
+		{name: "default"},
+		{name: "auto_discard", opts: []func(*GameState){WithAutoDiscardSingleOption(true), WithHandSize(4)}},
+		{name: "opening_discard_offer", opts: []func(*GameState){WithOpeningDiscardRule(OpeningDiscardOfferToBoth)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				unpooled := playSeededGame(t, seed, tt.opts...)
+				pooled := playSeededGame(t, seed, append([]func(*GameState){WithActionPool(true)}, tt.opts...)...)
+
+				expected, err := json.Marshal(unpooled)
+				require.NoError(t, err)
+				actual, err := json.Marshal(pooled)
+				require.NoError(t, err)
+				require.JSONEq(t, string(expected), string(actual), "seed %v", seed)
+			}
+		})
+	}
+}
+
+func TestPossibleActionsAreNotPooled(t *testing.T) {
+	g := New(WithActionPool(true))
+	possibleActions := g.CalculatePossibleActions()
+	expected := _serializeActions(possibleActions)
+
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+	discardAndEndTurn(t, g, g.Players[g.TurnPlayerID].Hand.Revealed[0])
+
+	require.Equal(t, expected, _serializeActions(possibleActions))
+}
+
+func benchmarkRandomGames(b *testing.B, opts ...func(*GameState)) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		playSeededGame(b, int64(i%20), opts...)
+	}
+}
+
+func BenchmarkRandomGames(b *testing.B) {
+	benchmarkRandomGames(b)
+}
+
+func BenchmarkRandomGamesWithActionPool(b *testing.B) {
+	benchmarkRandomGames(b, WithActionPool(true))
+}
@@ -0,0 +1,173 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errUnknownAction = errors.New("unknown action")
+	errMissingParam  = errors.New("missing param")
+	errInvalidParam  = errors.New("invalid param")
+)
+
+// NewAction builds an action from its name (e.g. DISCARD_CARD) and params, dispatching to the
+// right NewActionX constructor. It's meant for scripting and test generation; clients receiving
+// JSON should use DeserializeAction instead.
+//
+// Params are only needed by some actions:
+//
+//   - DISCARD_CARD requires "card".
+//   - MELD_CARDS requires "cards" and "meldType".
+//   - LAY_OFF_CARD requires "card", "meldPlayerID" and "meldIndex".
+//   - CUT_DECK requires "position".
+//
+// Cards may be given as a Card, or as a map with "suit" and "number" keys, as decoded from JSON.
+// The meld type may be given as a MeldType or a string.
+func NewAction(name string, playerID int, params map[string]any) (Action, error) {
+	switch name {
+	case DRAW_FROM_DRAW_PILE:
+		return NewActionDrawFromDrawPile(playerID), nil
+	case DRAW_FROM_DISCARD_PILE:
+		return NewActionDrawFromDiscardPile(playerID), nil
+	case DISCARD_CARD:
+		param, ok := params["card"]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v requires [card]", errMissingParam, name)
+		}
+		card, err := parseCardParam(param)
+		if err != nil {
+			return nil, err
+		}
+		return NewActionDiscardCard(card, playerID), nil
+	case MELD_CARDS:
+		cardsParam, ok := params["cards"]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v requires [cards]", errMissingParam, name)
+		}
+		meldTypeParam, ok := params["meldType"]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v requires [meldType]", errMissingParam, name)
+		}
+		cards, err := parseCardsParam(cardsParam)
+		if err != nil {
+			return nil, err
+		}
+		meldType, err := parseMeldTypeParam(meldTypeParam)
+		if err != nil {
+			return nil, err
+		}
+		return NewActionMeldCards(cards, meldType, playerID), nil
+	case LAY_OFF_CARD:
+		for _, key := range []string{"card", "meldPlayerID", "meldIndex"} {
+			if _, ok := params[key]; !ok {
+				return nil, fmt.Errorf("%w: %v requires [%v]", errMissingParam, name, key)
+			}
+		}
+		card, err := parseCardParam(params["card"])
+		if err != nil {
+			return nil, err
+		}
+		meldPlayerID, err := parseIntParam(params["meldPlayerID"])
+		if err != nil {
+			return nil, err
+		}
+		meldIndex, err := parseIntParam(params["meldIndex"])
+		if err != nil {
+			return nil, err
+		}
+		return NewActionLayOffCard(card, meldPlayerID, meldIndex, playerID), nil
+	case KNOCK:
+		return NewActionKnock(playerID), nil
+	case CONFIRM_ROUND_FINISHED:
+		return NewActionConfirmRoundFinished(playerID), nil
+	case END_TURN:
+		return NewActionEndTurn(playerID), nil
+	case DECLINE_OPENING_DISCARD:
+		return NewActionDeclineOpeningDiscard(playerID), nil
+	case CUT_DECK:
+		param, ok := params["position"]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v requires [position]", errMissingParam, name)
+		}
+		position, err := parseIntParam(param)
+		if err != nil {
+			return nil, err
+		}
+		return NewActionCutDeck(position, playerID), nil
+	case FORFEIT:
+		return NewActionForfeit(playerID), nil
+	case CONFIRM_REMATCH:
+		return NewActionConfirmRematch(playerID), nil
+	default:
+		return nil, fmt.Errorf("%w: [%v]", errUnknownAction, name)
+	}
+}
+
+func parseCardParam(param any) (Card, error) {
+	switch p := param.(type) {
+	case Card:
+		return p, nil
+	case map[string]any:
+		suit, ok := p["suit"].(string)
+		if !ok {
+			return Card{}, fmt.Errorf("%w: card [%v] has no suit", errInvalidParam, param)
+		}
+		var number int
+		switch n := p["number"].(type) {
+		case int:
+			number = n
+		case float64:
+			number = int(n)
+		default:
+			return Card{}, fmt.Errorf("%w: card [%v] has no number", errInvalidParam, param)
+		}
+		return Card{Suit: suit, Number: number}, nil
+	default:
+		return Card{}, fmt.Errorf("%w: [%v] is not a card", errInvalidParam, param)
+	}
+}
+
+func parseIntParam(param any) (int, error) {
+	switch p := param.(type) {
+	case int:
+		return p, nil
+	case float64:
+		return int(p), nil
+	default:
+		return 0, fmt.Errorf("%w: [%v] is not a number", errInvalidParam, param)
+	}
+}
+
+func parseCardsParam(param any) ([]Card, error) {
+	switch p := param.(type) {
+	case []Card:
+		return p, nil
+	case []any:
+		cards := make([]Card, 0, len(p))
+		for _, item := range p {
+			card, err := parseCardParam(item)
+			if err != nil {
+				return nil, err
+			}
+			cards = append(cards, card)
+		}
+		return cards, nil
+	default:
+		return nil, fmt.Errorf("%w: [%v] is not a list of cards", errInvalidParam, param)
+	}
+}
+
+func parseMeldTypeParam(param any) (MeldType, error) {
+	var meldType MeldType
+	switch p := param.(type) {
+	case MeldType:
+		meldType = p
+	case string:
+		meldType = MeldType(p)
+	}
+	if meldType != MeldTypeSet && meldType != MeldTypeRun {
+		return "", fmt.Errorf("%w: [%v] is not a meld type", errInvalidParam, param)
+	}
+	return meldType, nil
+}
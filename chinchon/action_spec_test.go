@@ -0,0 +1,79 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewActionBuildsEachActionType(t *testing.T) {
+	var (
+		card  = Card{Suit: COPA, Number: 3}
+		cards = []Card{{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}}
+	)
+
+	tests := []struct {
+		name     string
+		params   map[string]any
+		expected Action
+	}{
+		{name: DRAW_FROM_DRAW_PILE, expected: NewActionDrawFromDrawPile(1)},
+		{name: DRAW_FROM_DISCARD_PILE, expected: NewActionDrawFromDiscardPile(1)},
+		{name: DISCARD_CARD, params: map[string]any{"card": card}, expected: NewActionDiscardCard(card, 1)},
+		{name: MELD_CARDS, params: map[string]any{"cards": cards, "meldType": MeldTypeRun}, expected: NewActionMeldCards(cards, MeldTypeRun, 1)},
+		{name: LAY_OFF_CARD, params: map[string]any{"card": card, "meldPlayerID": 0, "meldIndex": 2}, expected: NewActionLayOffCard(card, 0, 2, 1)},
+		{name: KNOCK, expected: NewActionKnock(1)},
+		{name: CONFIRM_ROUND_FINISHED, expected: NewActionConfirmRoundFinished(1)},
+		{name: END_TURN, expected: NewActionEndTurn(1)},
+		{name: CUT_DECK, params: map[string]any{"position": 12}, expected: NewActionCutDeck(12, 1)},
+		{name: FORFEIT, expected: NewActionForfeit(1)},
+		{name: CONFIRM_REMATCH, expected: NewActionConfirmRematch(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := NewAction(tt.name, 1, tt.params)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, action)
+		})
+	}
+}
+
+func TestNewActionParsesJSONParams(t *testing.T) {
+	var params map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"cards": [{"suit": "oro", "number": 7}, {"suit": "copa", "number": 7}, {"suit": "espada", "number": 7}],
+		"meldType": "set"
+	}`), &params))
+
+	action, err := NewAction(MELD_CARDS, 0, params)
+	require.NoError(t, err)
+	require.Equal(t, NewActionMeldCards([]Card{{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}}, MeldTypeSet, 0), action)
+}
+
+func TestNewActionValidatesParams(t *testing.T) {
+	tests := []struct {
+		name        string
+		actionName  string
+		params      map[string]any
+		expectedErr error
+	}{
+		{name: "unknown_action", actionName: "shuffle", expectedErr: errUnknownAction},
+		{name: "discard_without_card", actionName: DISCARD_CARD, expectedErr: errMissingParam},
+		{name: "discard_with_invalid_card", actionName: DISCARD_CARD, params: map[string]any{"card": "3c"}, expectedErr: errInvalidParam},
+		{name: "discard_with_card_without_number", actionName: DISCARD_CARD, params: map[string]any{"card": map[string]any{"suit": ORO}}, expectedErr: errInvalidParam},
+		{name: "meld_without_cards", actionName: MELD_CARDS, params: map[string]any{"meldType": "set"}, expectedErr: errMissingParam},
+		{name: "meld_without_meld_type", actionName: MELD_CARDS, params: map[string]any{"cards": []Card{}}, expectedErr: errMissingParam},
+		{name: "lay_off_without_meld_index", actionName: LAY_OFF_CARD, params: map[string]any{"card": Card{Suit: ORO, Number: 1}, "meldPlayerID": 0}, expectedErr: errMissingParam},
+		{name: "lay_off_with_invalid_meld_index", actionName: LAY_OFF_CARD, params: map[string]any{"card": Card{Suit: ORO, Number: 1}, "meldPlayerID": 0, "meldIndex": "first"}, expectedErr: errInvalidParam},
+		{name: "meld_with_invalid_meld_type", actionName: MELD_CARDS, params: map[string]any{"cards": []Card{}, "meldType": "pair"}, expectedErr: errInvalidParam},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAction(tt.actionName, 0, tt.params)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -56,6 +56,10 @@ func NewActionMeldCards(cards []Card, meldType MeldType, playerID int) Action {
 	return &ActionMeldCards{act: act{Name: MELD_CARDS, PlayerID: playerID}, Cards: cards, MeldType: meldType}
 }
 
+func NewActionLayOffCard(card Card, meldPlayerID, meldIndex, playerID int) Action {
+	return &ActionLayOffCard{act: act{Name: LAY_OFF_CARD, PlayerID: playerID}, Card: card, MeldPlayerID: meldPlayerID, MeldIndex: meldIndex}
+}
+
 func NewActionKnock(playerID int) Action {
 	return &ActionKnock{act: act{Name: KNOCK, PlayerID: playerID}}
 }
@@ -64,3 +68,22 @@ func NewActionConfirmRoundFinished(playerID int) Action {
 	return &ActionConfirmRoundFinished{act: act{Name: CONFIRM_ROUND_FINISHED, PlayerID: playerID}}
 }
 
+func NewActionEndTurn(playerID int) Action {
+	return &ActionEndTurn{act: act{Name: END_TURN, PlayerID: playerID}}
+}
+
+func NewActionDeclineOpeningDiscard(playerID int) Action {
+	return &ActionDeclineOpeningDiscard{act: act{Name: DECLINE_OPENING_DISCARD, PlayerID: playerID}}
+}
+
+func NewActionCutDeck(position int, playerID int) Action {
+	return &ActionCutDeck{act: act{Name: CUT_DECK, PlayerID: playerID}, Position: position}
+}
+
+func NewActionForfeit(playerID int) Action {
+	return &ActionForfeit{act: act{Name: FORFEIT, PlayerID: playerID}}
+}
+
+func NewActionConfirmRematch(playerID int) Action {
+	return &ActionConfirmRematch{act: act{Name: CONFIRM_REMATCH, PlayerID: playerID}}
+}
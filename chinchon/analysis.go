@@ -0,0 +1,76 @@
+package chinchon
+
+import "errors"
+
+// WithAnalysisMode enables the full-information analysis view (see GameState.ToAnalysisGameState).
+//
+// This is meant for coaching and debugging only. NEVER enable it for competitive play, as it
+// reveals both players' hands and the order of the draw pile.
+func WithAnalysisMode(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleIsAnalysisMode = enabled
+	}
+}
+
+// AnalysisGameState is a full-information view of a Chinchón game, including both players' hands
+// and the order of the draw pile.
+//
+// UNSAFE for real games: it must never be sent to a player during competitive play. Use
+// ClientGameState for that.
+type AnalysisGameState struct {
+	RoundNumber  int `json:"roundNumber"`
+	TurnPlayerID int `json:"turnPlayerID"`
+
+	// Hands maps each player ID to the cards in their hand.
+	Hands map[int][]Card `json:"hands"`
+
+	// Melds maps each player ID to their laid down melds.
+	Melds map[int][]*Meld `json:"melds"`
+
+	// DeadwoodPoints maps each player ID to their current deadwood points.
+	DeadwoodPoints map[int]int `json:"deadwoodPoints"`
+
+	// Scores maps each player ID to their game score.
+	Scores map[int]int `json:"scores"`
+
+	// DrawPile is the draw pile in order. The last card is the next one to be drawn.
+	DrawPile []Card `json:"drawPile"`
+
+	// DiscardPile is the discard pile in order. The last card is the top card.
+	DiscardPile []Card `json:"discardPile"`
+
+	IsRoundFinished bool `json:"isRoundFinished"`
+	IsGameEnded     bool `json:"isGameEnded"`
+	WinnerPlayerID  int  `json:"winnerPlayerID"`
+}
+
+var errAnalysisModeDisabled = errors.New("analysis mode is disabled")
+
+// ToAnalysisGameState returns the full-information view of the game. It fails unless the game was
+// created with WithAnalysisMode(true).
+func (g *GameState) ToAnalysisGameState() (AnalysisGameState, error) {
+	if !g.RuleIsAnalysisMode {
+		return AnalysisGameState{}, errAnalysisModeDisabled
+	}
+
+	ags := AnalysisGameState{
+		RoundNumber:     g.RoundNumber,
+		TurnPlayerID:    g.TurnPlayerID,
+		Hands:           map[int][]Card{},
+		Melds:           map[int][]*Meld{},
+		DeadwoodPoints:  map[int]int{},
+		Scores:          map[int]int{},
+		DrawPile:        append([]Card{}, g.DrawPile.cards()...),
+		DiscardPile:     append([]Card{}, g.DiscardPile.cards()...),
+		IsRoundFinished: g.IsRoundFinished,
+		IsGameEnded:     g.IsGameEnded,
+		WinnerPlayerID:  g.WinnerPlayerID,
+	}
+	for playerID, player := range g.Players {
+		ags.Hands[playerID] = append([]Card{}, player.Hand.cards()...)
+		ags.Melds[playerID] = append([]*Meld{}, player.Melds...)
+		ags.DeadwoodPoints[playerID] = g.calculateDeadwoodPoints(player.Hand.cards(), player.Melds)
+		ags.Scores[playerID] = player.Score
+	}
+	return ags, nil
+}
@@ -0,0 +1,37 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalysisModeRevealsBothHands(t *testing.T) {
+	gameState := New(WithAnalysisMode(true))
+
+	ags, err := gameState.ToAnalysisGameState()
+	require.NoError(t, err)
+	require.Equal(t, gameState.Players[0].Hand.Revealed, ags.Hands[0])
+	require.Equal(t, gameState.Players[1].Hand.Revealed, ags.Hands[1])
+	require.Equal(t, gameState.DrawPile.Cards, ags.DrawPile)
+	require.Equal(t, gameState.DiscardPile.Cards, ags.DiscardPile)
+}
+
+func TestAnalysisModeIsDisabledByDefault(t *testing.T) {
+	gameState := New()
+
+	_, err := gameState.ToAnalysisGameState()
+	require.ErrorIs(t, err, errAnalysisModeDisabled)
+}
+
+func TestAnalysisGameStateIsACopy(t *testing.T) {
+	gameState := New(WithAnalysisMode(true))
+
+	ags, err := gameState.ToAnalysisGameState()
+	require.NoError(t, err)
+	ags.DrawPile[0] = Card{}
+	ags.Hands[0][0] = Card{}
+
+	require.NotEqual(t, Card{}, gameState.DrawPile.Cards[0])
+	require.NotEqual(t, Card{}, gameState.Players[0].Hand.Revealed[0])
+}
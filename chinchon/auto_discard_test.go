@@ -0,0 +1,80 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoDiscardSingleOption(t *testing.T) {
+	tests := []struct {
+		name            string
+		enabled         bool
+		expectDiscarded bool
+	}{
+		{name: "enabled_discards_automatically", enabled: true, expectDiscarded: true},
+		{name: "disabled_waits_for_the_player", enabled: false, expectDiscarded: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(WithAutoDiscardSingleOption(tt.enabled))
+			playerID := gameState.TurnPlayerID
+			// With an empty hand, the drawn card is the only one that can be discarded.
+			gameState.Players[playerID].Hand.Revealed = []Card{}
+			drawn, err := gameState.DrawPile.TopCard()
+			require.NoError(t, err)
+
+			require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+
+			require.Equal(t, tt.expectDiscarded, gameState.HasDiscardedThisTurn || gameState.TurnPlayerID != playerID)
+			if !tt.expectDiscarded {
+				require.Equal(t, []Card{drawn}, gameState.Players[playerID].Hand.Revealed)
+				return
+			}
+			require.Empty(t, gameState.Players[playerID].Hand.Revealed)
+			topCard, err := gameState.DiscardPile.TopCard()
+			require.NoError(t, err)
+			require.Equal(t, drawn, topCard)
+
+			actionsLog := gameState.RoundsLog[gameState.RoundNumber].ActionsLog
+			require.Len(t, actionsLog, 2)
+			require.Equal(t, SerializeAction(NewActionDiscardCard(drawn, playerID)), []byte(actionsLog[1].Action))
+		})
+	}
+}
+
+func TestAutoDiscardSingleOptionIgnoresChoices(t *testing.T) {
+	gameState := New(WithAutoDiscardSingleOption(true))
+	playerID := gameState.TurnPlayerID
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+
+	require.False(t, gameState.HasDiscardedThisTurn)
+	require.Len(t, gameState.Players[playerID].Hand.Revealed, DefaultHandSize+1)
+}
+
+// noDiscarding is a referee that vetoes every discard.
+type noDiscarding struct{}
+
+func (noDiscarding) Review(g GameState, a Action) (bool, string) {
+	return a.GetName() != DISCARD_CARD, ""
+}
+
+func TestVetoedAutoDiscardLeavesTheTurnWaitingForADiscard(t *testing.T) {
+	gameState := New(WithAutoDiscardSingleOption(true))
+	gameState.SetReferee(noDiscarding{})
+	playerID := gameState.TurnPlayerID
+	gameState.Players[playerID].Hand.Revealed = []Card{}
+	drawn, err := gameState.DrawPile.TopCard()
+	require.NoError(t, err)
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)), "the draw itself succeeded")
+
+	require.Equal(t, playerID, gameState.TurnPlayerID)
+	require.False(t, gameState.HasDiscardedThisTurn)
+	require.Equal(t, []Card{drawn}, gameState.Players[playerID].Hand.Revealed)
+	require.Contains(t, gameState.PossibleActions, json.RawMessage(SerializeAction(NewActionDiscardCard(drawn, playerID))))
+	require.Len(t, gameState.RoundsLog[gameState.RoundNumber].VetoedActionsLog, 1)
+}
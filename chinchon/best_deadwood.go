@@ -0,0 +1,28 @@
+package chinchon
+
+import "slices"
+
+// bestDeadwood is a player's best achievable deadwood, along with the hand it was computed for.
+type bestDeadwood struct {
+	hand     []Card
+	deadwood int
+}
+
+// BestDeadwood returns the lowest deadwood the player could reach by arranging their hand into
+// melds optimally (see OptimalMelds). Unlike the deadwood points in ClientGameState, which only
+// discount melds already laid on the table, this shows how much melding could still save.
+//
+// The result is cached until the player's hand changes.
+func (g GameState) BestDeadwood(playerID int) int {
+	hand := slices.Clone(g.Players[playerID].Hand.cards())
+	sortCardsBySuitAndNumber(hand)
+	if cached, ok := g.bestDeadwoods[playerID]; ok && slices.Equal(cached.hand, hand) {
+		return cached.deadwood
+	}
+
+	_, deadwood := g.bestMeldPartition(hand)
+	if g.bestDeadwoods != nil {
+		g.bestDeadwoods[playerID] = bestDeadwood{hand: hand, deadwood: deadwood}
+	}
+	return deadwood
+}
@@ -0,0 +1,65 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBestDeadwood(t *testing.T) {
+	tests := []struct {
+		name          string
+		hand          []Card
+		naiveDeadwood int
+		bestDeadwood  int
+	}{
+		{
+			name:          "no melds",
+			hand:          []Card{{Suit: ORO, Number: 1}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 12}},
+			naiveDeadwood: 14,
+			bestDeadwood:  14,
+		},
+		{
+			name: "set beats overlapping run",
+			hand: []Card{
+				{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7}, {Suit: COPA, Number: 7},
+				{Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 11}, {Suit: BASTO, Number: 12},
+			},
+			naiveDeadwood: 52,
+			bestDeadwood:  31,
+		},
+		{
+			name: "run and set share no card",
+			hand: []Card{
+				{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7}, {Suit: COPA, Number: 7},
+				{Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 7}, {Suit: BASTO, Number: 12},
+			},
+			naiveDeadwood: 49,
+			bestDeadwood:  10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New()
+			player := gameState.TurnPlayerID
+			gameState.Players[player].Hand.Revealed = tt.hand
+
+			require.Equal(t, tt.naiveDeadwood, gameState.calculateDeadwoodPoints(tt.hand, gameState.Players[player].Melds))
+			require.Equal(t, tt.bestDeadwood, gameState.BestDeadwood(player))
+			require.Equal(t, tt.bestDeadwood, gameState.ToClientGameState(player).YourBestDeadwoodPoints)
+		})
+	}
+}
+
+func TestBestDeadwoodIsRecomputedWhenTheHandChanges(t *testing.T) {
+	gameState := New()
+	player := gameState.TurnPlayerID
+	gameState.Players[player].Hand.Revealed = []Card{{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: COPA, Number: 2}}
+
+	require.Equal(t, 13, gameState.BestDeadwood(player))
+	require.Equal(t, 13, gameState.BestDeadwood(player))
+
+	gameState.Players[player].Hand.Revealed = append(gameState.Players[player].Hand.Revealed, Card{Suit: ORO, Number: 7})
+	require.Equal(t, 2, gameState.BestDeadwood(player))
+}
@@ -0,0 +1,35 @@
+package chinchon
+
+// WithBestOf turns the game into a best-of-n rounds series: the first player to win a majority of n
+// rounds wins the game, e.g. 2 rounds in a best-of-3, regardless of points. Scores are still kept,
+// but RuleMaxPoints no longer ends the game. Zero (the default) disables the rule.
+func WithBestOf(n int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleBestOf = n
+	}
+}
+
+// RoundsWon returns how many rounds each player has won so far.
+func (g GameState) RoundsWon() map[int]int {
+	roundsWon := map[int]int{}
+	for _, playerID := range g.PlayerOrder {
+		roundsWon[playerID] = 0
+	}
+	for _, roundLog := range g.RoundsLog[1:] {
+		if roundLog.WinnerPlayerID != -1 {
+			roundsWon[roundLog.WinnerPlayerID]++
+		}
+	}
+	return roundsWon
+}
+
+// seriesWinner returns the player who has clinched the best-of series, or -1 if nobody has yet.
+func (g GameState) seriesWinner() int {
+	roundsWon := g.RoundsWon()
+	for _, playerID := range g.PlayerOrder {
+		if roundsWon[playerID] > g.RuleBestOf/2 {
+			return playerID
+		}
+	}
+	return -1
+}
@@ -0,0 +1,57 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// winRound makes the given player win the current round by knocking, and confirms the result so
+// that the next round starts.
+func winRound(t *testing.T, g *GameState, playerID int) {
+	if g.TurnPlayerID != playerID {
+		g.changeTurn()
+	}
+	knockWinningRound(t, g)
+	require.Equal(t, playerID, g.RoundsLog[g.RoundNumber].WinnerPlayerID)
+	if !g.IsGameEnded {
+		require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+		require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+	}
+}
+
+func TestBestOfThreeEndsAtTwoNil(t *testing.T) {
+	g := New(WithBestOf(3))
+
+	winRound(t, g, 0)
+	require.False(t, g.IsGameEnded)
+	winRound(t, g, 0)
+
+	require.True(t, g.IsGameEnded)
+	require.Equal(t, 0, g.WinnerPlayerID)
+	require.Equal(t, 2, g.RoundNumber)
+	require.Equal(t, map[int]int{0: 2, 1: 0}, g.RoundsWon())
+}
+
+func TestBestOfThreeGoesToTwoOne(t *testing.T) {
+	g := New(WithBestOf(3))
+
+	winRound(t, g, 1)
+	winRound(t, g, 0)
+	require.False(t, g.IsGameEnded)
+	winRound(t, g, 1)
+
+	require.True(t, g.IsGameEnded)
+	require.Equal(t, 1, g.WinnerPlayerID)
+	require.Equal(t, 3, g.RoundNumber)
+	require.Equal(t, map[int]int{0: 1, 1: 2}, g.RoundsWon())
+}
+
+func TestBestOfIgnoresMaxPoints(t *testing.T) {
+	g := New(WithBestOf(3), WithMaxPoints(10))
+
+	winRound(t, g, 0)
+
+	require.False(t, g.IsGameEnded)
+	require.Equal(t, 60, g.Players[0].Score)
+}
@@ -0,0 +1,18 @@
+package chinchon
+
+// WithCardValues overrides how many deadwood points cards are worth, by card number, for variants
+// with other schemes, e.g. map[int]int{1: 15} makes aces worth 15 points. Numbers left out keep
+// their default value (see Card.DeadwoodValue).
+func WithCardValues(values map[int]int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleCardValues = values
+	}
+}
+
+// cardValue returns the points the card is worth as deadwood under the game's rules.
+func (g GameState) cardValue(card Card) int {
+	if value, ok := g.RuleCardValues[card.Number]; ok {
+		return value
+	}
+	return card.DeadwoodValue()
+}
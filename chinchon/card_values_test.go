@@ -0,0 +1,51 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomCardValuesChangeDeadwood(t *testing.T) {
+	hand := []Card{{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 12}}
+
+	tests := []struct {
+		name     string
+		opts     []func(*GameState)
+		expected int
+	}{
+		{name: "default_values", expected: 1 + 1 + 5 + 10},
+		{name: "ace_worth_15", opts: []func(*GameState){WithCardValues(map[int]int{1: 15})}, expected: 15 + 15 + 5 + 10},
+		{name: "capped_face_cards", opts: []func(*GameState){WithCardValues(map[int]int{10: 8, 11: 8, 12: 8})}, expected: 1 + 1 + 5 + 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New(tt.opts...)
+			g.Players[0].Hand.Revealed = hand
+			g.Players[0].Hand.Unrevealed = nil
+			g.Players[0].Melds = nil
+
+			require.Equal(t, tt.expected, g.calculateDeadwoodPoints(hand, nil))
+			require.Equal(t, tt.expected, g.BestDeadwood(0))
+			require.Equal(t, tt.expected, g.ToClientGameState(0).YourDeadwoodPoints)
+		})
+	}
+}
+
+func TestCustomCardValuesChangeTheBestMelds(t *testing.T) {
+	// The 1 de oro can go in the set of 1s or in the run of oros, leaving the rest of the other as
+	// deadwood: by default, that's the 1 de copa and 1 de espada, but not if 1s are worth 15.
+	hand := []Card{
+		{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1},
+		{Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+	}
+
+	melds, deadwood := New().bestMeldPartition(hand)
+	require.Equal(t, 1+1, deadwood)
+	require.Equal(t, MeldTypeRun, melds[0].Type)
+
+	melds, deadwood = New(WithCardValues(map[int]int{1: 15})).bestMeldPartition(hand)
+	require.Equal(t, 2+3, deadwood)
+	require.Equal(t, MeldTypeSet, melds[0].Type)
+}
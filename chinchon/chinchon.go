@@ -4,21 +4,44 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"sort"
 )
 
 // DefaultMaxPoints is the points a player must reach to win the game.
 // It is set as a const in case support for different point limits are needed in the future.
 const DefaultMaxPoints = 100
 
+const (
+	// DefaultHandSize is the number of cards dealt to each player at the start of a round.
+	DefaultHandSize = 7
+
+	// DefaultKnockThreshold is the maximum deadwood points a player may have in order to knock.
+	DefaultKnockThreshold = 10
+
+	// DefaultGinBonus is the bonus awarded to a round winner with zero deadwood points.
+	DefaultGinBonus = 25
+
+	// DefaultUndercutBonus is the bonus awarded to a round winner who didn't knock.
+	DefaultUndercutBonus = 10
+
+	// DefaultInitialDiscardCount is the number of cards that seed the discard pile at the start of
+	// a round.
+	DefaultInitialDiscardCount = 1
+)
+
 // Action names for Chinchón
 const (
-	DRAW_FROM_DRAW_PILE    = "draw_from_draw_pile"
-	DRAW_FROM_DISCARD_PILE = "draw_from_discard_pile"
-	DISCARD_CARD           = "discard_card"
-	MELD_CARDS             = "meld_cards"
-	KNOCK                  = "knock"
-	CONFIRM_ROUND_FINISHED = "confirm_round_finished"
+	DRAW_FROM_DRAW_PILE     = "draw_from_draw_pile"
+	DRAW_FROM_DISCARD_PILE  = "draw_from_discard_pile"
+	DISCARD_CARD            = "discard_card"
+	MELD_CARDS              = "meld_cards"
+	LAY_OFF_CARD            = "lay_off_card"
+	KNOCK                   = "knock"
+	CONFIRM_ROUND_FINISHED  = "confirm_round_finished"
+	END_TURN                = "end_turn"
+	DECLINE_OPENING_DISCARD = "decline_opening_discard"
+	CUT_DECK                = "cut_deck"
+	FORFEIT                 = "forfeit"
+	CONFIRM_REMATCH         = "confirm_rematch"
 )
 
 // Pile represents a pile of cards (like draw pile or discard pile).
@@ -27,18 +50,18 @@ type Pile struct {
 }
 
 // TopCard returns the top card of the pile without removing it.
-// Returns an error if the pile is empty.
+// Returns an error if the pile is empty or nil (e.g. before the first round is dealt).
 func (p *Pile) TopCard() (Card, error) {
-	if len(p.Cards) == 0 {
+	if p.IsEmpty() {
 		return Card{}, errors.New("pile is empty")
 	}
 	return p.Cards[len(p.Cards)-1], nil
 }
 
 // DrawCard removes and returns the top card from the pile.
-// Returns an error if the pile is empty.
+// Returns an error if the pile is empty or nil (e.g. before the first round is dealt).
 func (p *Pile) DrawCard() (Card, error) {
-	if len(p.Cards) == 0 {
+	if p.IsEmpty() {
 		return Card{}, errors.New("pile is empty")
 	}
 	card := p.Cards[len(p.Cards)-1]
@@ -51,9 +74,17 @@ func (p *Pile) AddCard(card Card) {
 	p.Cards = append(p.Cards, card)
 }
 
-// IsEmpty returns true if the pile has no cards.
+// IsEmpty returns true if the pile has no cards. A nil pile is empty.
 func (p *Pile) IsEmpty() bool {
-	return len(p.Cards) == 0
+	return p == nil || len(p.Cards) == 0
+}
+
+// cards returns the cards in the pile, or nil if the pile is nil.
+func (p *Pile) cards() []Card {
+	if p == nil {
+		return nil
+	}
+	return p.Cards
 }
 
 // MeldType represents the type of a meld.
@@ -70,8 +101,15 @@ type Meld struct {
 	Cards []Card   `json:"cards"`
 }
 
-// IsValid checks if this meld is valid according to Chinchón rules
+// IsValid checks if this meld is valid according to Chinchón rules, where runs never wrap around
+// from the king to the ace.
 func (m *Meld) IsValid() bool {
+	return m.IsValidWithAceWrap(AceWrapNone)
+}
+
+// IsValidWithAceWrap checks if this meld is valid according to Chinchón rules, letting runs wrap
+// around from the king to the ace as per the rule (see WithAceWrap).
+func (m *Meld) IsValidWithAceWrap(aceWrap AceWrap) bool {
 	switch m.Type {
 	case MeldTypeSet:
 		if len(m.Cards) < 3 {
@@ -86,25 +124,8 @@ func (m *Meld) IsValid() bool {
 		}
 		return true
 	case MeldTypeRun:
-		if len(m.Cards) < 3 {
-			return false
-		}
 		// All cards must have the same suit and be consecutive numbers
-		suit := m.Cards[0].Suit
-		numbers := make([]int, len(m.Cards))
-		for i, card := range m.Cards {
-			if card.Suit != suit {
-				return false
-			}
-			numbers[i] = card.Number
-		}
-		sort.Ints(numbers)
-		for i := 1; i < len(numbers); i++ {
-			if numbers[i] != numbers[i-1]+1 {
-				return false
-			}
-		}
-		return true
+		return isRun(m.Cards, aceWrap)
 	default:
 		return false
 	}
@@ -112,26 +133,27 @@ func (m *Meld) IsValid() bool {
 
 // calculateDeadwoodPoints calculates the deadwood points for a player's hand.
 // Cards in melds are not counted. Deadwood values: 1-7 = face value, 8-K = 10 points.
-func calculateDeadwoodPoints(hand []Card, melds []*Meld) int {
-	// Create a set of melded cards for quick lookup
-	meldedCards := make(map[Card]bool)
-	for _, meld := range melds {
-		for _, card := range meld.Cards {
-			meldedCards[card] = true
+func (g GameState) calculateDeadwoodPoints(hand []Card, melds []*Meld) int {
+	points := 0
+	for _, card := range hand {
+		if !isMelded(card, melds) {
+			points += g.cardValue(card)
 		}
 	}
+	return points
+}
 
-	points := 0
-	for _, card := range hand {
-		if !meldedCards[card] {
-			if card.Number >= 1 && card.Number <= 7 {
-				points += card.Number
-			} else {
-				points += 10
+// isMelded returns true if the card is part of any of the melds. A linear scan beats a lookup map
+// here, as there are only a handful of melded cards.
+func isMelded(card Card, melds []*Meld) bool {
+	for _, meld := range melds {
+		for _, meldCard := range meld.Cards {
+			if meldCard == card {
+				return true
 			}
 		}
 	}
-	return points
+	return false
 }
 
 // GameState represents the state of a Chinchón game. It is the central struct to this package.
@@ -144,14 +166,30 @@ type GameState struct {
 	// TurnPlayerID is the player ID of the player whose turn it is to play an action.
 	TurnPlayerID int `json:"turnPlayerID"`
 
-	// TurnOpponentPlayerID is the player ID of the opponent of the player whose turn it is.
+	// TurnOpponentPlayerID is the player ID of the opponent of the player whose turn it is. With
+	// more than two players, it's the player who plays next.
 	TurnOpponentPlayerID int `json:"turnOpponentPlayerID"`
 
+	// RoundTurnNumber is the number of the current turn within the round, starting from 1.
+	RoundTurnNumber int `json:"roundTurnNumber"`
+
+	// RoundLeaderPlayerID is the player who leads the current round: they play its first turn,
+	// unless another player takes the opening discard (see OpeningDiscardOfferToBoth).
+	RoundLeaderPlayerID int `json:"roundLeaderPlayerID"`
+
+	// RoundActionCount is the number of actions run so far in the current round, for
+	// RuleMaxActionsPerRound.
+	RoundActionCount int `json:"roundActionCount"`
+
 	// Players is a map of player IDs to their respective hands, melds, and scores.
-	// There are 2 players in a game. Use TurnPlayerID and TurnOpponentPlayerID to index
-	// into this map, or iterate over it to discover player ids.
+	// There are 2 players in a game, unless set otherwise (see WithPlayers). Use TurnPlayerID and
+	// TurnOpponentPlayerID to index into this map, or iterate over PlayerOrder to discover player ids.
 	Players map[int]*Player `json:"players"`
 
+	// PlayerOrder lists the ids in Players in seating order. Iterate over it, rather than over
+	// Players, wherever order matters, e.g. dealing, rotating turns or breaking ties.
+	PlayerOrder []int `json:"playerOrder"`
+
 	// PossibleActions is a list of possible actions that the current player can take.
 	// Possible actions are calculated based on game state and updated after each action.
 	PossibleActions []json.RawMessage `json:"possibleActions"`
@@ -168,6 +206,14 @@ type GameState struct {
 	// HasDiscardedThisTurn tracks whether the current player has discarded a card this turn.
 	HasDiscardedThisTurn bool `json:"hasDiscardedThisTurn"`
 
+	// IsOpeningDiscardOffered is true while the opening discard is offered to the turn player, who
+	// doesn't lead the round, before its first turn (see OpeningDiscardOfferToBoth).
+	IsOpeningDiscardOffered bool `json:"isOpeningDiscardOffered"`
+
+	// IsCutPending is true while the round waits for the player leading it to cut the deck, before
+	// dealing (see CutByOpponent).
+	IsCutPending bool `json:"isCutPending"`
+
 	// KnockedPlayerID is the player ID of the player who knocked (went out), or -1 if no one has knocked.
 	KnockedPlayerID int `json:"knockedPlayerID"`
 
@@ -184,6 +230,10 @@ type GameState struct {
 	// `true`. Otherwise, it's -1.
 	WinnerPlayerID int `json:"winnerPlayerID"`
 
+	// IsStallDetected is true if the game was aborted because the same position kept recurring
+	// (see WithStallDetection). The game is then ended without a winner.
+	IsStallDetected bool `json:"isStallDetected"`
+
 	// RoundsLog is the ordered list of logs of each round that was played in the game.
 	//
 	// Use GameState.RoundNumber to index into this list (note thus that it's 1-indexed).
@@ -194,9 +244,136 @@ type GameState struct {
 
 	RoundFinishedConfirmedPlayerIDs map[int]bool `json:"roundFinishedConfirmedPlayerIDs"`
 
+	// RematchConfirmedPlayerIDs are the players who confirmed a rematch since the game ended. Once
+	// every player has, the game starts over as its rematch (see Rematch).
+	RematchConfirmedPlayerIDs map[int]bool `json:"rematchConfirmedPlayerIDs"`
+
 	RuleMaxPoints int `json:"ruleMaxPoints"`
 
+	// RuleHandSize is the number of cards dealt to each player at the start of a round.
+	RuleHandSize int `json:"ruleHandSize"`
+
+	// RuleKnockThreshold is the maximum deadwood points a player may have in order to knock.
+	RuleKnockThreshold int `json:"ruleKnockThreshold"`
+
+	// RuleGinBonus is the bonus awarded to a round winner with zero deadwood points.
+	RuleGinBonus int `json:"ruleGinBonus"`
+
+	// RuleUndercutBonus is the bonus awarded to a round winner who didn't knock.
+	RuleUndercutBonus int `json:"ruleUndercutBonus"`
+
+	// RuleDeckSize is the number of cards in the deck: 40 (default) or 48 (including 8s and 9s).
+	RuleDeckSize int `json:"ruleDeckSize"`
+
+	// RuleInitialDiscardCount is the number of cards that seed the discard pile at the start of a
+	// round.
+	RuleInitialDiscardCount int `json:"ruleInitialDiscardCount"`
+
+	// RuleNoFirstTurnKnock forbids knocking during the first turn of a round.
+	RuleNoFirstTurnKnock bool `json:"ruleNoFirstTurnKnock"`
+
+	// RuleStartingScores maps player IDs to the score they start the game with, as a handicap.
+	RuleStartingScores map[int]int `json:"ruleStartingScores"`
+
+	// RuleAutoDiscardSingleOption automatically runs the discard when it's the only possible action.
+	RuleAutoDiscardSingleOption bool `json:"ruleAutoDiscardSingleOption"`
+
+	// RuleStallDetection is how many times the same position may recur within a round before the
+	// game is aborted for stalling. Zero disables stall detection.
+	RuleStallDetection int `json:"ruleStallDetection"`
+
+	// RuleMaxActionsPerRound is how many actions a round may run before it's ended without a knock
+	// (see WithMaxActionsPerRound). Zero disables the limit.
+	RuleMaxActionsPerRound int `json:"ruleMaxActionsPerRound"`
+
+	// RuleCompactFinishedRounds compacts the actions log of each finished round.
+	RuleCompactFinishedRounds bool `json:"ruleCompactFinishedRounds"`
+
+	// RuleDeclinedKnockPenalty is the extra points conceded by a round loser who declined to knock.
+	RuleDeclinedKnockPenalty int `json:"ruleDeclinedKnockPenalty"`
+
+	// RuleRoundPointsCap is the most points a single round can award, or zero for no cap.
+	RuleRoundPointsCap int `json:"ruleRoundPointsCap"`
+
+	// RuleRoundPointsRounding is the multiple the points awarded each round are rounded to, or
+	// zero for no rounding.
+	RuleRoundPointsRounding int `json:"ruleRoundPointsRounding"`
+
+	// RuleBestOf is the number of rounds in a best-of series, or zero if the game is played to
+	// RuleMaxPoints.
+	RuleBestOf int `json:"ruleBestOf"`
+
+	// RuleAceWrap is whether runs may wrap around from the king to the ace.
+	RuleAceWrap AceWrap `json:"ruleAceWrap"`
+
+	// RuleIsSubtractiveScoring makes players start at RuleMaxPoints and count down to zero.
+	RuleIsSubtractiveScoring bool `json:"ruleIsSubtractiveScoring"`
+
+	// RuleIsStrictDiscardDraw only allows drawing the top of the discard pile if it completes a meld.
+	RuleIsStrictDiscardDraw bool `json:"ruleIsStrictDiscardDraw"`
+
+	// RuleOpeningDiscard is whether the opening discard may be taken on the first turn of a round.
+	RuleOpeningDiscard OpeningDiscardRule `json:"ruleOpeningDiscard"`
+
+	// RuleCut is how the deck is cut after shuffling, before dealing.
+	RuleCut CutMode `json:"ruleCut"`
+
+	// RuleChinchonBonus is the bonus awarded to a round winner holding a chinchón.
+	RuleChinchonBonus int `json:"ruleChinchonBonus"`
+
+	// RuleChinchonEndsGame makes a chinchón win the whole game outright.
+	RuleChinchonEndsGame bool `json:"ruleChinchonEndsGame"`
+
+	// RuleActionLogging controls which actions are logged in each round's ActionsLog.
+	RuleActionLogging ActionLoggingLevel `json:"ruleActionLogging"`
+
+	// RuleIsOpenHands makes both hands visible to both players.
+	RuleIsOpenHands bool `json:"ruleIsOpenHands"`
+
+	// RuleIsTrainingMode adds learning aids to ClientGameState.
+	RuleIsTrainingMode bool `json:"ruleIsTrainingMode"`
+
+	// RuleIsAnalysisMode enables ToAnalysisGameState, which reveals all hidden information.
+	RuleIsAnalysisMode bool `json:"ruleIsAnalysisMode"`
+
+	// RuleIsDebugValidation makes RunAction validate the game state after each action (see
+	// WithDebugValidation).
+	RuleIsDebugValidation bool `json:"ruleIsDebugValidation"`
+
+	// RuleCardValues maps card numbers to the deadwood points they're worth, overriding the default
+	// values (see WithCardValues).
+	RuleCardValues map[int]int `json:"ruleCardValues"`
+
+	// Seed is the seed the deck is shuffled from (see WithSeed), or nil if shuffles are random.
+	Seed *int64 `json:"seed,omitempty"`
+
 	deck *deck `json:"-"`
+
+	// roundDeckOrder is the order of the deck at the start of the current round. It's secret until
+	// the round is finished, when it's revealed as RoundLog.DeckOrder.
+	roundDeckOrder []Card
+
+	// positionCounts counts how many times each position (see Hash) occurred this round, for stall
+	// detection.
+	positionCounts map[uint64]int
+
+	// drewFromDiscardCard is the card the turn player took from the discard pile this turn, which
+	// they can't discard straight back, or nil.
+	drewFromDiscardCard *Card
+
+	// referee reviews actions before they run, if set (see SetReferee).
+	referee Referee
+
+	// observers are called with every event that happens while running actions (see AddObserver).
+	observers []func(Event)
+
+	// isActionPooled reuses the actions built to work out the possible actions (see
+	// WithActionPool).
+	isActionPooled bool
+
+	// bestDeadwoods caches each player's best achievable deadwood for their current hand (see
+	// BestDeadwood).
+	bestDeadwoods map[int]bestDeadwood
 }
 
 type Player struct {
@@ -206,7 +383,9 @@ type Player struct {
 	// Melds contains the melded combinations (sets and runs) laid down by the player.
 	Melds []*Meld `json:"melds"`
 
-	// Score is the player's total score (from 0 to MaxPoints).
+	// Score is the player's total score. It counts up from 0 to RuleMaxPoints, or down from
+	// RuleMaxPoints to 0 with subtractive scoring (see WithSubtractiveScoring). Players with a
+	// handicap start from their starting score instead (see WithStartingScores).
 	Score int `json:"score"`
 }
 
@@ -218,13 +397,24 @@ type RoundLog struct {
 	// MeldsDealt is a map from PlayerID to their melds at the end of the round.
 	MeldsDealt map[int][]*Meld `json:"meldsDealt"`
 
+	// InitialDiscardPile is the discard pile at the start of the round, i.e. the face up card(s)
+	// seeded from the deck.
+	InitialDiscardPile []Card `json:"initialDiscardPile"`
+
+	// FinalDiscardPile and FinalDrawPileCount are the discard pile and the number of cards left in
+	// the draw pile when the round finished, for post-game analysis. They're only set once the round
+	// is finished. Their cards don't carry over: every round is dealt from a fresh, full deck.
+	FinalDiscardPile   []Card `json:"finalDiscardPile"`
+	FinalDrawPileCount int    `json:"finalDrawPileCount"`
+
 	// KnockedPlayerID is the player who knocked to end the round, or -1 if no one knocked.
 	KnockedPlayerID int `json:"knockedPlayerID"`
 
-	// WinnerPlayerID is the player who won this round (the one with lower deadwood points).
+	// WinnerPlayerID is the player who won this round (the one with lower deadwood points), or -1 if
+	// the round is unfinished or a draw.
 	WinnerPlayerID int `json:"winnerPlayerID"`
 
-	// LoserPlayerID is the player who lost this round.
+	// LoserPlayerID is the player who lost this round, or -1 if the round is unfinished or a draw.
 	LoserPlayerID int `json:"loserPlayerID"`
 
 	// WinnerDeadwoodPoints is the deadwood point total of the winner.
@@ -236,8 +426,47 @@ type RoundLog struct {
 	// PointsAwarded is the number of points awarded to the winner.
 	PointsAwarded int `json:"pointsAwarded"`
 
-	// ActionsLog is the ordered list of actions of this round.
+	// DrawPileReshuffles is how many times the discard pile was reshuffled into the exhausted draw
+	// pile during this round.
+	DrawPileReshuffles int `json:"drawPileReshuffles"`
+
+	// LastReshuffleActionCount is how many actions were logged this round when the discard pile was
+	// last reshuffled into the draw pile, if it ever was.
+	LastReshuffleActionCount int `json:"lastReshuffleActionCount,omitempty"`
+
+	// DeclinedKnockPlayerIDs are the IDs of the players who ended a turn this round when they
+	// could have knocked (see WithDeclinedKnockPenalty). Unlike ActionsLog, it's kept at every
+	// action logging level.
+	DeclinedKnockPlayerIDs []int `json:"declinedKnockPlayerIDs,omitempty"`
+
+	// IsChinchon is true if the winner held a chinchón, a run of the same suit spanning their whole
+	// hand.
+	IsChinchon bool `json:"isChinchon"`
+
+	// IsDraw is true if the round ended with equal deadwood and no one having knocked, so no one
+	// won it: WinnerPlayerID and LoserPlayerID are -1, and no points are awarded.
+	IsDraw bool `json:"isDraw,omitempty"`
+
+	// IsMaxActionsReached is true if the round was ended without a knock because it ran too many
+	// actions (see WithMaxActionsPerRound).
+	IsMaxActionsReached bool `json:"isMaxActionsReached,omitempty"`
+
+	// ActionsLog is the ordered list of actions of this round. It's empty if the round was
+	// compacted; use RoundLog.Actions to read it regardless.
 	ActionsLog []ActionLog `json:"actionsLog"`
+
+	// DeckOrder is the order of the shuffled deck this round was dealt from, so that auditors can
+	// verify the shuffle was fair after the fact. Cards were dealt alternately to players in
+	// PlayerOrder from the front, and the draw pile is the rest, drawn from the back. It's only set once the
+	// round is finished or the game ended, so it never leaks mid-round.
+	DeckOrder []Card `json:"deckOrder,omitempty"`
+
+	// VetoedActionsLog is the ordered list of actions of this round that the referee vetoed, along
+	// with the referee's reasons.
+	VetoedActionsLog []ActionLog `json:"vetoedActionsLog,omitempty"`
+
+	// CompactActionsLog is the move notation of ActionsLog, only set if the round was compacted.
+	CompactActionsLog string `json:"compactActionsLog,omitempty"`
 }
 
 // ActionLog is a log of an action that was run in a round.
@@ -248,6 +477,9 @@ type ActionLog struct {
 	// Action is a JSON-serialized action. This is because `Action` is an interface, and we can't
 	// serialize it directly otherwise. Clients should use `chinchon.DeserializeAction`.`
 	Action json.RawMessage `json:"action"`
+
+	// RefereeNote is the referee's annotation of the action, if any (see GameState.SetReferee).
+	RefereeNote string `json:"refereeNote,omitempty"`
 }
 
 // WithMaxPoints sets the maximum points required to win the game.
@@ -257,6 +489,82 @@ func WithMaxPoints(maxPoints int) func(*GameState) {
 	}
 }
 
+// WithHandSize sets the number of cards dealt to each player at the start of a round. Sizes below
+// one, or too large to deal every player a hand and seed the discard pile with cards left to draw,
+// are ignored.
+func WithHandSize(handSize int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleHandSize = handSize
+	}
+}
+
+// WithKnockThreshold sets the maximum deadwood points a player may have in order to knock.
+func WithKnockThreshold(knockThreshold int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleKnockThreshold = knockThreshold
+	}
+}
+
+// WithGinBonus sets the bonus awarded to a round winner with zero deadwood points.
+func WithGinBonus(ginBonus int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleGinBonus = ginBonus
+	}
+}
+
+// WithUndercutBonus sets the bonus awarded to a round winner who didn't knock.
+func WithUndercutBonus(undercutBonus int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleUndercutBonus = undercutBonus
+	}
+}
+
+// WithDeckSize sets the number of cards in the deck: 40 (default) or 48 (including 8s and 9s). Any
+// other size is ignored.
+func WithDeckSize(deckSize int) func(*GameState) {
+	return func(gs *GameState) {
+		if deckSize != DefaultDeckSize && deckSize != 48 {
+			return
+		}
+		gs.RuleDeckSize = deckSize
+	}
+}
+
+// WithInitialDiscardCount sets how many cards seed the discard pile at the start of a round. With
+// zero, the first player must draw from the draw pile. Counts that are negative or leave no cards
+// to draw after dealing are ignored.
+func WithInitialDiscardCount(count int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleInitialDiscardCount = count
+	}
+}
+
+// WithStartingScores makes players start the game with the given scores rather than zero, so that
+// a stronger player can spot points to a weaker one. Scores must be below the maximum points;
+// invalid scores are ignored.
+func WithStartingScores(scores map[int]int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleStartingScores = scores
+	}
+}
+
+// WithAutoDiscardSingleOption makes the game discard automatically when there's only one legal
+// discard, e.g. when a player is left holding a single card after drawing. The discard runs
+// through RunAction, so it's validated and logged like any other action.
+func WithAutoDiscardSingleOption(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleAutoDiscardSingleOption = enabled
+	}
+}
+
+// WithNoFirstTurnKnock forbids knocking during the first turn of a round, so that a player dealt an
+// immediately knockable hand can't end the round before any play has happened.
+func WithNoFirstTurnKnock(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleNoFirstTurnKnock = enabled
+	}
+}
+
 func New(opts ...func(*GameState)) *GameState {
 	gs := &GameState{
 		RoundNumber:          0,
@@ -266,76 +574,99 @@ func New(opts ...func(*GameState)) *GameState {
 			0: {Hand: nil, Melds: nil, Score: 0},
 			1: {Hand: nil, Melds: nil, Score: 0},
 		},
-		IsGameEnded:          false,
-		WinnerPlayerID:       -1,
-		RoundsLog:            []*RoundLog{{}}, // initialised with an empty round to be 1-indexed
-		KnockedPlayerID:      -1,
-		HasDrawnThisTurn:     false,
-		HasDiscardedThisTurn: false,
-		deck:                 newDeck(),
-		RuleMaxPoints:        DefaultMaxPoints,
+		PlayerOrder:             []int{0, 1},
+		IsGameEnded:             false,
+		WinnerPlayerID:          -1,
+		RoundsLog:               []*RoundLog{{}}, // initialised with an empty round to be 1-indexed
+		KnockedPlayerID:         -1,
+		HasDrawnThisTurn:        false,
+		HasDiscardedThisTurn:    false,
+		deck:                    newDeck(),
+		bestDeadwoods:           map[int]bestDeadwood{},
+		RuleMaxPoints:           DefaultMaxPoints,
+		RuleHandSize:            DefaultHandSize,
+		RuleKnockThreshold:      DefaultKnockThreshold,
+		RuleGinBonus:            DefaultGinBonus,
+		RuleUndercutBonus:       DefaultUndercutBonus,
+		RuleChinchonBonus:       DefaultChinchonBonus,
+		RuleDeckSize:            DefaultDeckSize,
+		RuleInitialDiscardCount: DefaultInitialDiscardCount,
+		RuleMaxActionsPerRound:  DefaultMaxActionsPerRound,
 	}
 
 	for _, opt := range opts {
 		opt(gs)
 	}
 
+	for _, player := range gs.Players {
+		player.Score = gs.initialScore()
+	}
+	for playerID, score := range gs.RuleStartingScores {
+		// Starting scores must leave something to play for; invalid ones are ignored.
+		if player, ok := gs.Players[playerID]; ok && gs.isValidStartingScore(score) {
+			player.Score = score
+		}
+	}
+
+	// The initial discard count is checked first, against the hand size that will be dealt, so that
+	// a count that doesn't fit is ignored rather than making a valid hand size fall back to the
+	// default. The hand size is then checked with the count that stands.
+	deckSize := len(spanishCards(gs.RuleDeckSize))
+	handSize := gs.RuleHandSize
+	if handSize < 1 || len(gs.PlayerOrder)*handSize >= deckSize {
+		handSize = DefaultHandSize
+	}
+	if count := gs.RuleInitialDiscardCount; count < 0 || len(gs.PlayerOrder)*handSize+count >= deckSize {
+		gs.RuleInitialDiscardCount = DefaultInitialDiscardCount
+	}
+	if handSize := gs.RuleHandSize; handSize < 1 || len(gs.PlayerOrder)*handSize+gs.RuleInitialDiscardCount >= deckSize {
+		gs.RuleHandSize = DefaultHandSize
+	}
+
 	gs.startNewRound()
 
 	return gs
 }
 
 func (g *GameState) startNewRound() {
-	g.deck.shuffle()
+	if g.RuleCompactFinishedRounds && g.RoundNumber > 0 {
+		// On failure, the detailed log is kept, which is always safe.
+		_ = g.RoundsLog[g.RoundNumber].Compact()
+	}
+	g.reseedRound(g.RoundNumber + 1)
+	g.deck.shuffle(g.RuleDeckSize)
 	g.RoundNumber++
+	g.positionCounts = map[uint64]int{}
+
+	// Stash the shuffled order, to be revealed in the round's log once the round is finished.
+	g.roundDeckOrder = append([]Card{}, g.deck.cards...)
 
 	// Alternate who starts the round
 	g.TurnPlayerID = g.OpponentOf(g.TurnPlayerID)
 	g.TurnOpponentPlayerID = g.OpponentOf(g.TurnPlayerID)
+	g.RoundLeaderPlayerID = g.TurnPlayerID
 
-	// Deal 7 cards to each player
-	player0Hand := &Hand{}
-	player1Hand := &Hand{}
-	for i := 0; i < 7; i++ {
-		player0Hand.Revealed = append(player0Hand.Revealed, g.deck.cards[0])
-		g.deck.cards = g.deck.cards[1:]
-		player1Hand.Revealed = append(player1Hand.Revealed, g.deck.cards[0])
-		g.deck.cards = g.deck.cards[1:]
-	}
-
-	g.Players[0].Hand = player0Hand
-	g.Players[1].Hand = player1Hand
-	g.Players[0].Melds = []*Meld{}
-	g.Players[1].Melds = []*Meld{}
-
-	// Create draw pile with remaining cards
-	g.DrawPile = &Pile{Cards: make([]Card, len(g.deck.cards))}
-	copy(g.DrawPile.Cards, g.deck.cards)
-
-	// Create discard pile with one card from draw pile
-	g.DiscardPile = &Pile{}
-	if !g.DrawPile.IsEmpty() {
-		if card, err := g.DrawPile.DrawCard(); err == nil {
-			g.DiscardPile.AddCard(card)
-		}
+	// Nothing is dealt until the deck is cut, if the leader must cut it first.
+	for _, playerID := range g.PlayerOrder {
+		g.Players[playerID].Hand = &Hand{}
+		g.Players[playerID].Melds = []*Meld{}
 	}
+	g.DrawPile = &Pile{Cards: []Card{}}
+	g.DiscardPile = &Pile{Cards: []Card{}}
 
 	// Reset round state
+	g.RoundTurnNumber = 1
+	g.RoundActionCount = 0
 	g.KnockedPlayerID = -1
 	g.HasDrawnThisTurn = false
+	g.drewFromDiscardCard = nil
 	g.HasDiscardedThisTurn = false
 	g.IsRoundFinished = false
 	g.RoundFinishedConfirmedPlayerIDs = map[int]bool{}
 
 	g.RoundsLog = append(g.RoundsLog, &RoundLog{
-		HandsDealt: map[int]*Hand{
-			0: g.Players[0].Hand,
-			1: g.Players[1].Hand,
-		},
-		MeldsDealt: map[int][]*Meld{
-			0: g.Players[0].Melds,
-			1: g.Players[1].Melds,
-		},
+		HandsDealt:           map[int]*Hand{},
+		MeldsDealt:           map[int][]*Meld{},
 		KnockedPlayerID:      -1,
 		WinnerPlayerID:       -1,
 		LoserPlayerID:        -1,
@@ -345,106 +676,303 @@ func (g *GameState) startNewRound() {
 		ActionsLog:           []ActionLog{},
 	})
 
+	switch g.RuleCut {
+	case CutRandom:
+		g.cutDeck(g.randomCutPosition())
+	case CutByOpponent:
+		g.IsCutPending = true
+		g.PossibleActions = _serializeActions(g.CalculatePossibleActions())
+		return
+	}
+	g.dealRound()
+}
+
+// dealRound deals the round from the deck, once it's shuffled and cut.
+func (g *GameState) dealRound() {
+	// Deal cards to each player, one at a time in seating order
+	for i := 0; i < g.RuleHandSize; i++ {
+		for _, playerID := range g.PlayerOrder {
+			hand := g.Players[playerID].Hand
+			hand.Revealed = append(hand.Revealed, g.deck.cards[0])
+			g.deck.cards = g.deck.cards[1:]
+		}
+	}
+
+	// Create draw pile with remaining cards
+	g.DrawPile = &Pile{Cards: make([]Card, len(g.deck.cards))}
+	copy(g.DrawPile.Cards, g.deck.cards)
+
+	// Create discard pile with cards from the draw pile
+	g.DiscardPile = &Pile{Cards: []Card{}}
+	for i := 0; i < g.RuleInitialDiscardCount && !g.DrawPile.IsEmpty(); i++ {
+		if card, err := g.DrawPile.DrawCard(); err == nil {
+			g.DiscardPile.AddCard(card)
+		}
+	}
+
+	roundLog := g.RoundsLog[g.RoundNumber]
+	roundLog.HandsDealt = map[int]*Hand{}
+	roundLog.MeldsDealt = map[int][]*Meld{}
+	for _, playerID := range g.PlayerOrder {
+		hand := g.Players[playerID].Hand.DeepCopy()
+		roundLog.HandsDealt[playerID] = &hand
+		roundLog.MeldsDealt[playerID] = g.Players[playerID].Melds
+	}
+	roundLog.InitialDiscardPile = append([]Card{}, g.DiscardPile.Cards...)
+
+	g.replenishDrawPile()
+	g.offerOpeningDiscard()
 	g.PossibleActions = _serializeActions(g.CalculatePossibleActions())
 }
 
+// RunAction runs the action, if it's possible, and moves the game on. A game aborted by stall
+// detection doesn't make RunAction fail: it ends with IsStallDetected set (see WithStallDetection).
 func (g *GameState) RunAction(action Action) error {
+	if err := g.runAction(action); err != nil {
+		return err
+	}
+	if g.RuleIsDebugValidation {
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("%w, after running [%v]", err, action)
+		}
+	}
+	return nil
+}
+
+func (g *GameState) runAction(action Action) error {
 	if action == nil {
 		return nil
 	}
 
-	if g.IsGameEnded {
-		return fmt.Errorf("%w trying to run [%v]", errGameIsEnded, action)
+	if g.IsGameEnded && action.GetName() != CONFIRM_REMATCH {
+		return fmt.Errorf("%w trying to run [%v]", ErrGameIsEnded, action)
 	}
 
-	if !g.IsRoundFinished && action.GetPlayerID() != g.TurnPlayerID {
-		return errNotYourTurn
+	if !g.IsGameEnded && !g.IsRoundFinished && action.GetPlayerID() != g.TurnPlayerID && !isForfeit(action) {
+		return ErrNotYourTurn
 	}
 
 	if !action.IsPossible(*g) {
-		return fmt.Errorf("%w trying to run [%v]", errActionNotPossible, action)
+		return fmt.Errorf("%w trying to run [%v]", ErrActionNotPossible, action)
+	}
+	refereeNote, err := g.review(action)
+	if err != nil {
+		return err
 	}
-	err := action.Run(g)
+	wasRoundFinished, wasGameEnded := g.IsRoundFinished, g.IsGameEnded
+	err = action.Run(g)
 	if err != nil {
 		return fmt.Errorf("%w trying to run [%v] after checking it was possible", err, action)
 	}
 
-	if action.GetName() != CONFIRM_ROUND_FINISHED {
+	if g.shouldLogAction(action) {
 		g.RoundsLog[g.RoundNumber].ActionsLog = append(g.RoundsLog[g.RoundNumber].ActionsLog, ActionLog{
-			PlayerID: g.TurnPlayerID,
-			Action:   SerializeAction(action),
+			PlayerID:    action.GetPlayerID(),
+			Action:      SerializeAction(action),
+			RefereeNote: refereeNote,
 		})
 	}
 
+	g.emitActionEvent(action)
+	g.countRoundAction(action)
+
+	if (g.IsRoundFinished || g.IsGameEnded) && g.RoundsLog[g.RoundNumber].DeckOrder == nil {
+		g.RoundsLog[g.RoundNumber].DeckOrder = g.roundDeckOrder
+	}
+	if g.IsRoundFinished && !wasRoundFinished {
+		roundLog := g.RoundsLog[g.RoundNumber]
+		g.emit(Event{Type: EventRoundFinished, PlayerID: roundLog.WinnerPlayerID, RoundNumber: g.RoundNumber, PointsAwarded: roundLog.PointsAwarded})
+	}
+
+	// Start the rematch once every player confirmed it
+	if wasGameEnded {
+		if len(g.RematchConfirmedPlayerIDs) == len(g.PlayerOrder) {
+			g.startRematch()
+			g.emit(Event{Type: EventRoundStarted, PlayerID: g.TurnPlayerID, RoundNumber: g.RoundNumber})
+			return nil
+		}
+		g.PossibleActions = _serializeActions(g.CalculatePossibleActions())
+		return nil
+	}
+
 	// Start new round if current round is finished
-	if !g.IsGameEnded && g.IsRoundFinished && len(g.RoundFinishedConfirmedPlayerIDs) == 2 {
+	if !g.IsGameEnded && g.IsRoundFinished && len(g.RoundFinishedConfirmedPlayerIDs) == len(g.PlayerOrder) {
 		// fmt.Println("Starting new round...")
 		g.startNewRound()
+		g.emit(Event{Type: EventRoundStarted, PlayerID: g.TurnPlayerID, RoundNumber: g.RoundNumber})
 		return nil
 	}
 
 	// Switch player turn within current round (unless current action doesn't yield turn)
 	if !g.IsGameEnded && !g.IsRoundFinished && action.YieldsTurn(*g) {
-		g.TurnPlayerID, g.TurnOpponentPlayerID = g.TurnOpponentPlayerID, g.TurnPlayerID
+		g.TurnPlayerID, g.TurnOpponentPlayerID = g.TurnOpponentPlayerID, g.OpponentOf(g.TurnOpponentPlayerID)
+		g.RoundTurnNumber++
 		// Reset turn state for the new player
 		g.HasDrawnThisTurn = false
+		g.drewFromDiscardCard = nil
 		g.HasDiscardedThisTurn = false
 	}
 
-	if !g.IsGameEnded && g.IsRoundFinished && len(g.RoundFinishedConfirmedPlayerIDs) == 1 {
-		if g.RoundFinishedConfirmedPlayerIDs[g.TurnPlayerID] {
-			g.changeTurn()
+	// The turn goes to the next player left to confirm the round finished
+	if !g.IsGameEnded && g.IsRoundFinished && g.RoundFinishedConfirmedPlayerIDs[g.TurnPlayerID] {
+		for _, playerID := range g.seatingFrom(g.TurnPlayerID) {
+			if !g.RoundFinishedConfirmedPlayerIDs[playerID] {
+				g.TurnPlayerID, g.TurnOpponentPlayerID = playerID, g.OpponentOf(playerID)
+				break
+			}
 		}
 	}
 
-	// Handle end of game due to score
-	for playerID := range g.Players {
-		if g.Players[playerID].Score >= g.RuleMaxPoints {
-			g.Players[playerID].Score = g.RuleMaxPoints
+	if g.detectStall() {
+		g.emit(Event{Type: EventGameEnded, PlayerID: -1, RoundNumber: g.RoundNumber})
+		return nil
+	}
+
+	if g.RuleBestOf > 0 {
+		// Handle end of game due to a clinched best-of series
+		if winner := g.seriesWinner(); winner != -1 {
 			g.IsGameEnded = true
-			g.WinnerPlayerID = playerID
+			g.WinnerPlayerID = winner
+		}
+	} else {
+		// Handle end of game due to score. Scores are only capped at the winning score once the
+		// winner is picked, as several players may reach it at once.
+		if g.WinnerPlayerID == -1 {
+			g.WinnerPlayerID = g.furthestPastWinningScore()
+		}
+		for _, playerID := range g.PlayerOrder {
+			if g.hasReachedWinningScore(playerID) {
+				g.Players[playerID].Score = g.winningScore()
+				g.IsGameEnded = true
+			}
 		}
 	}
+	if g.IsGameEnded {
+		g.emit(Event{Type: EventGameEnded, PlayerID: g.WinnerPlayerID, RoundNumber: g.RoundNumber})
+	}
 
-	possibleActions := g.CalculatePossibleActions()
-	if g.countActionsOfTurnPlayer() == 0 {
+	// Replenishing at the start of a turn keeps the latest discard on top of the discard pile
+	if !g.IsGameEnded && !g.IsRoundFinished && !g.HasDrawnThisTurn {
+		g.replenishDrawPile()
+	}
+
+	possibleActions, f := g.calculatePooledPossibleActions()
+	if !g.IsGameEnded && g.countActionsOfTurnPlayer() == 0 {
 		// If the current player has no actions left, it's the opponent's turn.
 		g.changeTurn()
-		possibleActions = g.CalculatePossibleActions()
+		f.release(possibleActions...)
+		possibleActions, f = g.calculatePooledPossibleActions()
 	}
 
 	g.PossibleActions = _serializeActions(possibleActions)
 
+	// A forced discard is run straight away, so that it doesn't require a client round-trip. It's
+	// not released, as a referee reviewing it may keep it. The action that forced it has already
+	// run, so if the discard fails, e.g. vetoed by the referee, it isn't this action's error: the
+	// turn waits for the player to discard instead.
+	if forced := onlyAction(possibleActions); g.RuleAutoDiscardSingleOption && forced != nil && forced.GetName() == DISCARD_CARD {
+		_ = g.runAction(forced)
+		return nil
+	}
+	f.release(possibleActions...)
+
 	// log.Printf("Possible actions: %v\n", possibleActions)
 
 	return nil
 }
 
+// onlyAction returns the only possible action other than forfeiting, or nil if there are more.
+func onlyAction(possibleActions []Action) Action {
+	var only Action
+	for _, action := range possibleActions {
+		switch {
+		case isForfeit(action):
+			continue
+		case only != nil:
+			return nil
+		}
+		only = action
+	}
+	return only
+}
+
+// changeTurn passes the turn to the next player round the table.
 func (g *GameState) changeTurn() {
-	g.TurnPlayerID, g.TurnOpponentPlayerID = g.TurnOpponentPlayerID, g.TurnPlayerID
+	g.TurnPlayerID = g.OpponentOf(g.TurnPlayerID)
+	g.TurnOpponentPlayerID = g.OpponentOf(g.TurnPlayerID)
 }
 
 func (g GameState) countActionsOfTurnPlayer() int {
 	count := 0
-	for _, a := range g.CalculatePossibleActions() {
-		if a.GetPlayerID() == g.TurnPlayerID {
+	possibleActions, f := g.calculatePooledPossibleActions()
+	for _, a := range possibleActions {
+		if a.GetPlayerID() == g.TurnPlayerID && !isForfeit(a) {
 			count++
 		}
 	}
+	f.release(possibleActions...)
 	return count
 }
 
+// OpponentOf returns the player seated after playerID in PlayerOrder, wrapping around. With two
+// players, that's the other player.
 func (g GameState) OpponentOf(playerID int) int {
-	for id := range g.Players {
-		if id != playerID {
-			return id
+	for i, id := range g.PlayerOrder {
+		if id == playerID {
+			return g.PlayerOrder[(i+1)%len(g.PlayerOrder)]
 		}
 	}
 	return -1 // Unreachable
 }
 
+// serializedGameState is a GameState along with the unexported state that RunAction needs to
+// continue the game after Deserialize.
+type serializedGameState struct {
+	*GameState
+	RoundDeckOrder      []Card         `json:"roundDeckOrder"`
+	PositionCounts      map[uint64]int `json:"positionCounts"`
+	DrewFromDiscardCard *Card          `json:"drewFromDiscardCard,omitempty"`
+}
+
+// Serialize returns the full game state as JSON, including hidden information, e.g. so that a
+// server can persist it. Use Deserialize to restore it.
 func (g GameState) Serialize() ([]byte, error) {
-	return json.Marshal(g)
+	return json.Marshal(serializedGameState{
+		GameState:           &g,
+		RoundDeckOrder:      g.roundDeckOrder,
+		PositionCounts:      g.positionCounts,
+		DrewFromDiscardCard: g.drewFromDiscardCard,
+	})
+}
+
+// Deserialize restores a game state serialized with GameState.Serialize, so that RunAction
+// continues the game exactly as the original would have, including the deals of seeded games.
+//
+// The options the game was created with (which rematches reuse), its referee and its observers
+// can't be serialized: rematches of a restored game use the default rules, and a referee and
+// observers must be set again with SetReferee and AddObserver.
+func Deserialize(bs []byte) (*GameState, error) {
+	g := &GameState{}
+	state := serializedGameState{GameState: g}
+	if err := json.Unmarshal(bs, &state); err != nil {
+		return nil, err
+	}
+
+	// The deck only holds the undealt cards, which are the draw pile, until the next round is dealt.
+	g.deck = newDeck()
+	g.deck.cards = append([]Card{}, g.DrawPile.cards()...)
+	g.roundDeckOrder = state.RoundDeckOrder
+	if g.IsCutPending {
+		// Nothing is dealt yet, so the deck is still whole.
+		g.deck.cards = append([]Card{}, g.roundDeckOrder...)
+	}
+	g.drewFromDiscardCard = state.DrewFromDiscardCard
+	g.positionCounts = state.PositionCounts
+	if g.positionCounts == nil {
+		g.positionCounts = map[uint64]int{}
+	}
+	g.bestDeadwoods = map[int]bestDeadwood{}
+	return g, nil
 }
 
 func (g *GameState) PrettyPrint() (string, error) {
@@ -456,23 +984,40 @@ func (g *GameState) PrettyPrint() (string, error) {
 	return string(prettyJSON), nil
 }
 
-// generatePossibleMeldActions generates all possible valid meld actions for a player
-func (g *GameState) generatePossibleMeldActions(playerID int) []Action {
-	actions := []Action{}
-	hand := g.Players[playerID].Hand.Revealed
+// LegalMelds returns every meld the player could lay down from their hand: all sets of 3 cards
+// and all runs of 3 or more cards, which may overlap. It's the melds behind the player's meld
+// actions, for clients and analysis that want the melds themselves.
+func (g GameState) LegalMelds(playerID int) []*Meld {
+	return g.legalMelds(g.Players[playerID].Hand.cards())
+}
 
+// LegalMelds returns every meld that could be laid down from the hand under the default rules, e.g.
+// so that a UI can highlight meldable cards without a game. Use GameState.LegalMelds to follow a
+// game's rules, e.g. runs that wrap around (see WithAceWrap).
+func LegalMelds(hand []Card) []*Meld {
+	return GameState{}.legalMelds(hand)
+}
+
+func (g GameState) legalMelds(hand []Card) []*Meld {
 	// Generate all possible sets (3+ cards of same rank)
-	actions = append(actions, g.generateSetMeldActions(hand, playerID)...)
+	melds := g.generateSetMelds(hand)
 
 	// Generate all possible runs (3+ consecutive cards of same suit)
-	actions = append(actions, g.generateRunMeldActions(hand, playerID)...)
+	return append(melds, g.generateRunMelds(hand)...)
+}
 
+// generatePossibleMeldActions generates all possible valid meld actions for a player
+func (g *GameState) generatePossibleMeldActions(playerID int) []Action {
+	actions := []Action{}
+	for _, meld := range g.LegalMelds(playerID) {
+		actions = append(actions, NewActionMeldCards(meld.Cards, meld.Type, playerID))
+	}
 	return actions
 }
 
-// generateSetMeldActions generates all possible set melds (same rank, different suits)
-func (g *GameState) generateSetMeldActions(hand []Card, playerID int) []Action {
-	actions := []Action{}
+// generateSetMelds generates all possible set melds (same rank, different suits)
+func (g *GameState) generateSetMelds(hand []Card) []*Meld {
+	melds := []*Meld{}
 
 	// Group cards by rank
 	rankGroups := make(map[int][]Card)
@@ -480,27 +1025,27 @@ func (g *GameState) generateSetMeldActions(hand []Card, playerID int) []Action {
 		rankGroups[card.Number] = append(rankGroups[card.Number], card)
 	}
 
-	// For each rank with 3+ cards, generate all possible combinations of 3 cards
-	for _, cards := range rankGroups {
-		if len(cards) >= 3 {
+	// For each rank with 3+ cards, generate all possible combinations of 3 cards. Ranks are visited
+	// in order, so that possible actions are listed deterministically.
+	for _, rank := range sortedKeys(rankGroups) {
+		if cards := rankGroups[rank]; len(cards) >= 3 {
 			// Generate combinations of 3 cards from the available cards
 			combinations := g.generateCombinations(cards, 3)
 			for _, combo := range combinations {
 				// Check if it's a valid set (different suits)
 				if g.isValidSet(combo) {
-					action := NewActionMeldCards(combo, MeldTypeSet, playerID)
-					actions = append(actions, action)
+					melds = append(melds, &Meld{Type: MeldTypeSet, Cards: combo})
 				}
 			}
 		}
 	}
 
-	return actions
+	return melds
 }
 
-// generateRunMeldActions generates all possible run melds (consecutive ranks, same suit)
-func (g *GameState) generateRunMeldActions(hand []Card, playerID int) []Action {
-	actions := []Action{}
+// generateRunMelds generates all possible run melds (consecutive ranks, same suit)
+func (g *GameState) generateRunMelds(hand []Card) []*Meld {
+	melds := []*Meld{}
 
 	// Group cards by suit
 	suitGroups := make(map[string][]Card)
@@ -508,9 +1053,9 @@ func (g *GameState) generateRunMeldActions(hand []Card, playerID int) []Action {
 		suitGroups[card.Suit] = append(suitGroups[card.Suit], card)
 	}
 
-	// For each suit, find all possible runs
-	for _, cards := range suitGroups {
-		if len(cards) >= 3 {
+	// For each suit in deck order, find all possible runs
+	for _, suit := range []string{ORO, COPA, ESPADA, BASTO} {
+		if cards := suitGroups[suit]; len(cards) >= 3 {
 			// Sort cards by number
 			sortedCards := make([]Card, len(cards))
 			copy(sortedCards, cards)
@@ -525,13 +1070,12 @@ func (g *GameState) generateRunMeldActions(hand []Card, playerID int) []Action {
 			// Find all consecutive sequences of 3+ cards
 			runs := g.findConsecutiveRuns(sortedCards)
 			for _, run := range runs {
-				action := NewActionMeldCards(run, MeldTypeRun, playerID)
-				actions = append(actions, action)
+				melds = append(melds, &Meld{Type: MeldTypeRun, Cards: run})
 			}
 		}
 	}
 
-	return actions
+	return melds
 }
 
 // generateCombinations generates all combinations of size k from the given cards
@@ -560,7 +1104,8 @@ func (g *GameState) generateCombinations(cards []Card, k int) [][]Card {
 	return combinations
 }
 
-// findConsecutiveRuns finds all maximal consecutive runs in sorted cards
+// findConsecutiveRuns finds all runs of 3+ consecutive cards in sorted cards, including those that
+// wrap around from the king to the ace if the rule allows it (see WithAceWrap).
 func (g *GameState) findConsecutiveRuns(sortedCards []Card) [][]Card {
 	var runs [][]Card
 
@@ -588,7 +1133,7 @@ func (g *GameState) findConsecutiveRuns(sortedCards []Card) [][]Card {
 		i++
 	}
 
-	return runs
+	return append(runs, wrappedRuns(sortedCards, g.RuleAceWrap)...)
 }
 
 // isValidSet checks if the given cards form a valid set (same rank, different suits)
@@ -613,54 +1158,121 @@ func (g *GameState) isValidSet(cards []Card) bool {
 	return true
 }
 
-// calculateRoundScore calculates the scores for both players at the end of a round
+// finishRound scores the round, and logs how it ended.
+func (g *GameState) finishRound() {
+	g.calculateRoundScore()
+
+	roundLog := g.RoundsLog[g.RoundNumber]
+	roundLog.MeldsDealt = map[int][]*Meld{}
+	for _, playerID := range g.PlayerOrder {
+		roundLog.MeldsDealt[playerID] = append([]*Meld(nil), g.Players[playerID].Melds...)
+	}
+	roundLog.FinalDiscardPile = append([]Card{}, g.DiscardPile.cards()...)
+	roundLog.FinalDrawPileCount = len(g.DrawPile.cards())
+
+	g.IsRoundFinished = true
+}
+
+// calculateRoundScore calculates the scores for all players at the end of a round
 func (g *GameState) calculateRoundScore() {
 	roundLog := g.RoundsLog[g.RoundNumber]
 
-	// Calculate deadwood for both players
-	player0Deadwood := calculateDeadwoodPoints(g.Players[0].Hand.Revealed, g.Players[0].Melds)
-	player1Deadwood := calculateDeadwoodPoints(g.Players[1].Hand.Revealed, g.Players[1].Melds)
-
-	roundLog.WinnerDeadwoodPoints = player0Deadwood
-	roundLog.LoserDeadwoodPoints = player1Deadwood
-	roundLog.WinnerPlayerID = 0
-	roundLog.LoserPlayerID = 1
-
-	// Determine winner (lower deadwood wins)
-	if player1Deadwood < player0Deadwood {
-		roundLog.WinnerDeadwoodPoints = player1Deadwood
-		roundLog.LoserDeadwoodPoints = player0Deadwood
-		roundLog.WinnerPlayerID = 1
-		roundLog.LoserPlayerID = 0
-	} else if player1Deadwood == player0Deadwood {
-		// Tie goes to the player who didn't knock, or if both knocked, to the non-knocker
-		// For simplicity, if it's a tie, the non-knocker wins
-		if roundLog.KnockedPlayerID == 0 {
-			roundLog.WinnerPlayerID = 1
-			roundLog.LoserPlayerID = 0
-		} else {
-			roundLog.WinnerPlayerID = 0
-			roundLog.LoserPlayerID = 1
-		}
+	// Calculate deadwood for all players, counting cards that form melds as melded whether they
+	// were laid down or not
+	deadwoods := map[int]int{}
+	for _, playerID := range g.PlayerOrder {
+		deadwoods[playerID] = g.BestDeadwood(playerID)
 	}
 
-	// Calculate points awarded
-	winnerDeadwood := roundLog.WinnerDeadwoodPoints
-	loserDeadwood := roundLog.LoserDeadwoodPoints
-	points := loserDeadwood - winnerDeadwood
+	// Determine winner: lowest deadwood wins, and a tie goes to a player who didn't knock, as a
+	// knocker who doesn't beat their opponents is undercut. A tie with no knocker is a draw.
+	roundLog.WinnerPlayerID = g.lowestDeadwoodPlayerID(deadwoods, roundLog.KnockedPlayerID)
 
-	// Bonus for going gin (0 deadwood)
-	if winnerDeadwood == 0 {
-		points += 25
+	// A chinchón wins the round outright, with no deadwood whether it was melded or not
+	if chinchonPlayerID := g.chinchonPlayerID(); chinchonPlayerID != -1 {
+		roundLog.IsChinchon = true
+		roundLog.WinnerPlayerID = chinchonPlayerID
+		deadwoods[chinchonPlayerID] = 0
+	}
+
+	if roundLog.WinnerPlayerID == -1 {
+		roundLog.IsDraw = true
+		roundLog.WinnerDeadwoodPoints = deadwoods[g.PlayerOrder[0]]
+		roundLog.LoserDeadwoodPoints = deadwoods[g.PlayerOrder[0]]
+		for _, deadwood := range deadwoods {
+			roundLog.WinnerDeadwoodPoints = min(roundLog.WinnerDeadwoodPoints, deadwood)
+			roundLog.LoserDeadwoodPoints = max(roundLog.LoserDeadwoodPoints, deadwood)
+		}
+		roundLog.PointsAwarded = 0
+		return
+	}
+
+	// The loser is the player with the highest deadwood, but the winner is awarded the difference
+	// with every other player's deadwood
+	winnerDeadwood := deadwoods[roundLog.WinnerPlayerID]
+	points := 0
+	for _, playerID := range g.seatingFrom(roundLog.WinnerPlayerID)[1:] {
+		if roundLog.LoserPlayerID == -1 || deadwoods[playerID] > deadwoods[roundLog.LoserPlayerID] {
+			roundLog.LoserPlayerID = playerID
+		}
+		points += deadwoods[playerID] - winnerDeadwood
+	}
+	roundLog.WinnerDeadwoodPoints = winnerDeadwood
+	roundLog.LoserDeadwoodPoints = deadwoods[roundLog.LoserPlayerID]
+
+	// Bonus for a chinchón, or otherwise for going gin (0 deadwood)
+	if roundLog.IsChinchon {
+		points += g.RuleChinchonBonus
+	} else if winnerDeadwood == 0 {
+		points += g.RuleGinBonus
 	}
 
 	// Bonus for undercutting (opponent has higher deadwood when you knock)
 	if roundLog.KnockedPlayerID != -1 && roundLog.KnockedPlayerID != roundLog.WinnerPlayerID {
-		points += 10
+		points += g.RuleUndercutBonus
+	}
+
+	// Penalty for the loser having declined to knock earlier in the round
+	if g.RuleDeclinedKnockPenalty > 0 && g.declinedKnock(roundLog.LoserPlayerID) {
+		points += g.RuleDeclinedKnockPenalty
 	}
 
+	// House rules limiting blowout rounds
+	points = g.limitRoundPoints(points)
+
 	roundLog.PointsAwarded = points
-	g.Players[roundLog.WinnerPlayerID].Score += points
+	g.awardPoints(roundLog.WinnerPlayerID, points)
+
+	if roundLog.IsChinchon && g.RuleChinchonEndsGame {
+		g.IsGameEnded = true
+		g.WinnerPlayerID = roundLog.WinnerPlayerID
+	}
+}
+
+// lowestDeadwoodPlayerID returns the player with the lowest deadwood. Ties go to the first tied player
+// seated after the knocker, or are a draw (-1) if nobody knocked.
+func (g GameState) lowestDeadwoodPlayerID(deadwoods map[int]int, knockedPlayerID int) int {
+	tied := []int{}
+	for _, playerID := range g.PlayerOrder {
+		switch {
+		case len(tied) == 0 || deadwoods[playerID] < deadwoods[tied[0]]:
+			tied = []int{playerID}
+		case deadwoods[playerID] == deadwoods[tied[0]]:
+			tied = append(tied, playerID)
+		}
+	}
+	switch {
+	case len(tied) == 1:
+		return tied[0]
+	case knockedPlayerID == -1:
+		return -1
+	}
+	for _, playerID := range g.seatingFrom(knockedPlayerID)[1:] {
+		if deadwoods[playerID] == deadwoods[tied[0]] {
+			return playerID
+		}
+	}
+	return -1 // Unreachable
 }
 
 type Action interface {
@@ -688,40 +1300,70 @@ type Action interface {
 	fmt.Stringer
 }
 
+// Errors returned by GameState.RunAction when an action is rejected. They may be wrapped, so use
+// errors.Is to check for them.
 var (
-	errActionNotPossible = errors.New("action not possible")
-	errGameIsEnded       = errors.New("game is ended")
-	errNotYourTurn       = errors.New("not your turn")
+	// ErrActionNotPossible means the action isn't possible in the current game state.
+	ErrActionNotPossible = errors.New("action not possible")
+
+	// ErrGameIsEnded means the game is over, so no more actions can be run.
+	ErrGameIsEnded = errors.New("game is ended")
+
+	// ErrNotYourTurn means the action belongs to a player whose turn it isn't.
+	ErrNotYourTurn = errors.New("not your turn")
 )
 
 func (g GameState) CalculatePossibleActions() []Action {
+	return g.calculatePossibleActions(actionFactory{})
+}
+
+// calculatePooledPossibleActions is CalculatePossibleActions, but building actions from the pools if
+// the game pools actions (see WithActionPool). The actions must be released once done with.
+func (g GameState) calculatePooledPossibleActions() ([]Action, actionFactory) {
+	f := actionFactory{isPooled: g.isActionPooled}
+	return g.calculatePossibleActions(f), f
+}
+
+func (g GameState) calculatePossibleActions(f actionFactory) []Action {
 	allActions := []Action{}
 
-	// If round is finished, both players can confirm
-	if g.IsRoundFinished {
-		allActions = append(allActions,
-			NewActionConfirmRoundFinished(g.TurnPlayerID),
-			NewActionConfirmRoundFinished(g.TurnOpponentPlayerID),
-		)
+	// Once the game has ended, all that's left is confirming a rematch
+	if g.IsGameEnded {
+		for _, playerID := range g.seatingFrom(g.TurnPlayerID) {
+			allActions = append(allActions, NewActionConfirmRematch(playerID))
+		}
+	} else if g.IsRoundFinished {
+		// If round is finished, every player can confirm
+		for _, playerID := range g.seatingFrom(g.TurnPlayerID) {
+			allActions = append(allActions, NewActionConfirmRoundFinished(playerID))
+		}
 	} else {
 		// Normal turn actions
-		if !g.HasDrawnThisTurn {
+		if g.IsCutPending {
+			// The deck must be cut first, leaving at least a card on either side of the cut
+			for position := 1; position < len(g.deck.cards); position++ {
+				allActions = append(allActions, NewActionCutDeck(position, g.TurnPlayerID))
+			}
+		} else if !g.HasDrawnThisTurn {
 			// Player must draw first
 			allActions = append(allActions,
-				NewActionDrawFromDrawPile(g.TurnPlayerID),
-				NewActionDrawFromDiscardPile(g.TurnPlayerID),
+				f.drawFromDrawPile(g.TurnPlayerID),
+				f.drawFromDiscardPile(g.TurnPlayerID),
+				f.declineOpeningDiscard(g.TurnPlayerID),
 			)
 		} else if !g.HasDiscardedThisTurn {
 			// Player must discard after drawing
-			for _, card := range g.Players[g.TurnPlayerID].Hand.Revealed {
-				allActions = append(allActions, NewActionDiscardCard(card, g.TurnPlayerID))
+			for _, card := range g.Players[g.TurnPlayerID].Hand.cards() {
+				allActions = append(allActions, f.discardCard(card, g.TurnPlayerID))
 			}
 		} else {
-			// Player has drawn and discarded, can now meld or knock
-			allActions = append(allActions, NewActionKnock(g.TurnPlayerID))
+			// Player has drawn and discarded, can now meld or knock, or end their turn
+			allActions = append(allActions, f.knock(g.TurnPlayerID))
 			// Add all possible meld actions
 			meldActions := g.generatePossibleMeldActions(g.TurnPlayerID)
 			allActions = append(allActions, meldActions...)
+			allActions = append(allActions, g.generateLayOffActions(g.TurnPlayerID)...)
+			allActions = append(allActions, f.endTurn(g.TurnPlayerID))
 		}
 	}
 
@@ -730,8 +1372,17 @@ func (g GameState) CalculatePossibleActions() []Action {
 		action.Enrich(g)
 		if action.IsPossible(g) {
 			possibleActions = append(possibleActions, action)
+		} else {
+			f.release(action)
 		}
 	}
+
+	// The turn player may also forfeit while playing their turn. Players may forfeit at any other
+	// time too, but it's only offered alongside a turn's actions to keep the confirmations and cuts
+	// in between them uncluttered.
+	if len(possibleActions) > 0 && !g.IsGameEnded && !g.IsRoundFinished && !g.IsCutPending {
+		possibleActions = append(possibleActions, NewActionForfeit(g.TurnPlayerID))
+	}
 	return possibleActions
 }
 
@@ -760,10 +1411,22 @@ func DeserializeAction(bs []byte) (Action, error) {
 		action = &ActionDiscardCard{}
 	case MELD_CARDS:
 		action = &ActionMeldCards{}
+	case LAY_OFF_CARD:
+		action = &ActionLayOffCard{}
 	case KNOCK:
 		action = &ActionKnock{}
 	case CONFIRM_ROUND_FINISHED:
 		action = &ActionConfirmRoundFinished{}
+	case END_TURN:
+		action = &ActionEndTurn{}
+	case DECLINE_OPENING_DISCARD:
+		action = &ActionDeclineOpeningDiscard{}
+	case CUT_DECK:
+		action = &ActionCutDeck{}
+	case FORFEIT:
+		action = &ActionForfeit{}
+	case CONFIRM_REMATCH:
+		action = &ActionConfirmRematch{}
 	default:
 		return nil, fmt.Errorf("unknown action: [%v]", string(bs))
 	}
@@ -816,9 +1479,14 @@ func (g *GameState) ToClientGameState(youPlayerID int) ClientGameState {
 
 	// GameState may have possible game actions that this player can't take.
 	filteredPossibleActions := []Action{}
+	var knockPreview *KnockPreview
 	for _, a := range g.CalculatePossibleActions() {
 		if a.GetPlayerID() == youPlayerID {
 			filteredPossibleActions = append(filteredPossibleActions, a)
+			if a.GetName() == KNOCK {
+				preview := g.PreviewKnock(youPlayerID)
+				knockPreview = &preview
+			}
 		}
 	}
 
@@ -829,8 +1497,10 @@ func (g *GameState) ToClientGameState(youPlayerID int) ClientGameState {
 		ThemPlayerID:        themPlayerID,
 		YourScore:           g.Players[youPlayerID].Score,
 		TheirScore:          g.Players[themPlayerID].Score,
-		YourHandCards:       g.Players[youPlayerID].Hand.Revealed,
-		TheirHandCards:      g.Players[themPlayerID].Hand.Revealed,
+		YourPointsToWin:     g.PointsToWin(youPlayerID),
+		TheirPointsToWin:    g.PointsToWin(themPlayerID),
+		YourHandCards:       g.Players[youPlayerID].Hand.cards(),
+		TheirHandCards:      g.visibleHandCards(themPlayerID),
 		YourMelds:           g.Players[youPlayerID].Melds,
 		TheirMelds:          g.Players[themPlayerID].Melds,
 		DiscardPileTopCard:  func() Card { card, _ := g.DiscardPile.TopCard(); return card }(),
@@ -839,9 +1509,44 @@ func (g *GameState) ToClientGameState(youPlayerID int) ClientGameState {
 		IsRoundFinished:     g.IsRoundFinished,
 		WinnerPlayerID:      g.WinnerPlayerID,
 		KnockedPlayerID:     g.KnockedPlayerID,
-		YourDeadwoodPoints:  calculateDeadwoodPoints(g.Players[youPlayerID].Hand.Revealed, g.Players[youPlayerID].Melds),
-		TheirDeadwoodPoints: calculateDeadwoodPoints(g.Players[themPlayerID].Hand.Revealed, g.Players[themPlayerID].Melds),
+		YourDeadwoodPoints:  g.calculateDeadwoodPoints(g.Players[youPlayerID].Hand.cards(), g.Players[youPlayerID].Melds),
+		TheirDeadwoodPoints: g.visibleDeadwoodPoints(themPlayerID),
 		RuleMaxPoints:       g.RuleMaxPoints,
+		SeenCards:           g.seenCards(youPlayerID),
+		KnockPreview:        knockPreview,
+	}
+
+	cgs.RuleIsSubtractiveScoring = g.RuleIsSubtractiveScoring
+	cgs.RuleBestOf = g.RuleBestOf
+	cgs.RuleAceWrap = g.RuleAceWrap
+	cgs.RuleIsStrictDiscardDraw = g.RuleIsStrictDiscardDraw
+	cgs.RuleOpeningDiscard = g.RuleOpeningDiscard
+	cgs.IsOpeningDiscardOffered = g.IsOpeningDiscardOffered
+	cgs.IsPostKnockPhase = g.isPostKnockPhase()
+	cgs.KnockerPlayerID = -1
+	if cgs.IsPostKnockPhase {
+		cgs.KnockerPlayerID = g.KnockedPlayerID
+	}
+	cgs.RuleCut = g.RuleCut
+	cgs.RuleCardValues = g.RuleCardValues
+	cgs.IsCutPending = g.IsCutPending
+	cgs.EngineVersion = Version()
+	cgs.GameResult = g.gameResult()
+	cgs.RoundSummary = g.roundSummary()
+	cgs.YourHandCount = len(g.Players[youPlayerID].Hand.cards())
+	cgs.TheirHandCount = len(g.Players[themPlayerID].Hand.cards())
+	cgs.YourMeldsDetailed = g.meldViews(youPlayerID)
+	for _, playerID := range g.seatingFrom(youPlayerID)[1:] {
+		cgs.Opponents = append(cgs.Opponents, g.clientOpponent(playerID))
+	}
+	cgs.YourBestDeadwoodPoints = g.BestDeadwood(youPlayerID)
+	cgs.IsYourTurn, cgs.TurnReason = g.turnReason(youPlayerID)
+	cgs.TurnsUntilStockEmpty = g.TurnsUntilStockEmpty()
+
+	if g.RuleIsTrainingMode {
+		cgs.RemainingCardEstimate = g.remainingCardEstimate(youPlayerID)
+		winProbability := g.clientWinProbability(youPlayerID)
+		cgs.WinProbability = &winProbability
 	}
 
 	if len(g.RoundsLog[g.RoundNumber].ActionsLog) > 0 {
@@ -852,6 +1557,30 @@ func (g *GameState) ToClientGameState(youPlayerID int) ClientGameState {
 	return cgs
 }
 
+// ClientOpponent is what a client sees of one of the other players (see ClientGameState.Opponents).
+type ClientOpponent struct {
+	PlayerID       int     `json:"playerID"`
+	Score          int     `json:"score"`
+	PointsToWin    int     `json:"pointsToWin"`
+	HandCards      []Card  `json:"handCards"`
+	HandCount      int     `json:"handCount"`
+	Melds          []*Meld `json:"melds"`
+	DeadwoodPoints int     `json:"deadwoodPoints"`
+}
+
+func (g *GameState) clientOpponent(playerID int) ClientOpponent {
+	player := g.Players[playerID]
+	return ClientOpponent{
+		PlayerID:       playerID,
+		Score:          player.Score,
+		PointsToWin:    g.PointsToWin(playerID),
+		HandCards:      g.visibleHandCards(playerID),
+		HandCount:      len(player.Hand.cards()),
+		Melds:          player.Melds,
+		DeadwoodPoints: g.visibleDeadwoodPoints(playerID),
+	}
+}
+
 // ClientGameState represents the state of a Chinchón game as available to a client.
 //
 // It is returned by the server on every single call, so if you want to implement a client,
@@ -873,6 +1602,19 @@ type ClientGameState struct {
 	TheirMelds         []*Meld `json:"theirMelds"`
 	DiscardPileTopCard Card    `json:"discardPileTopCard"`
 
+	// YourHandCount and TheirHandCount are how many cards are in each hand, e.g. to render the
+	// right number of face-down cards. Unlike TheirHandCards, TheirHandCount is always set.
+	YourHandCount  int `json:"yourHandCount"`
+	TheirHandCount int `json:"theirHandCount"`
+
+	// YourMeldsDetailed is YourMelds laid out for rendering, in the same order.
+	YourMeldsDetailed []MeldView `json:"yourMeldsDetailed"`
+
+	// Opponents are all the other players, in seating order starting with ThemPlayerID, who plays
+	// after you. The Their* fields only describe ThemPlayerID, so clients of games with more than
+	// two players (see WithPlayers) should use Opponents instead.
+	Opponents []ClientOpponent `json:"opponents"`
+
 	// PossibleActions is a list of possible actions that the current player can take.
 	PossibleActions []json.RawMessage `json:"possibleActions"`
 
@@ -889,16 +1631,99 @@ type ClientGameState struct {
 	// KnockedPlayerID is the player who knocked to end the round, or -1 if no one has knocked.
 	KnockedPlayerID int `json:"knockedPlayerID"`
 
-	// Deadwood points for each player (calculated from unmelded cards)
+	// IsPostKnockPhase is true once a knock ended the round, while the players confirm its result,
+	// e.g. so that the UI can show who it's waiting for. KnockerPlayerID is who knocked, and it's -1
+	// outside of this phase. Knocking ends the round straight away, so only confirmations are left.
+	IsPostKnockPhase bool `json:"isPostKnockPhase"`
+	KnockerPlayerID  int  `json:"knockerPlayerID"`
+
+	// Deadwood points for each player (calculated from unmelded cards). TheirDeadwoodPoints only
+	// counts the cards you may see, so it's zero unless hands are open (see WithOpenHands).
 	YourDeadwoodPoints  int `json:"yourDeadwoodPoints"`
 	TheirDeadwoodPoints int `json:"theirDeadwoodPoints"`
 
+	// YourPointsToWin and TheirPointsToWin are how many more points each player needs to win the
+	// game (see GameState.PointsToWin).
+	YourPointsToWin  int `json:"yourPointsToWin"`
+	TheirPointsToWin int `json:"theirPointsToWin"`
+
+	// YourBestDeadwoodPoints is the lowest deadwood you could reach by melding your hand optimally
+	// (see GameState.BestDeadwood), as opposed to YourDeadwoodPoints, which only counts laid melds.
+	YourBestDeadwoodPoints int `json:"yourBestDeadwoodPoints"`
+
+	// SeenCards lists every card you have definitively seen this round: your dealt hand, the cards
+	// you drew, every card that was face up on the discard pile, and all melds on the table. Cards
+	// are listed in deck order. Bots can use it to estimate which cards remain in the draw pile.
+	SeenCards []Card `json:"seenCards"`
+
+	// RemainingCardEstimate maps each suit to how many of its cards you haven't seen this round,
+	// i.e. that may be in the draw pile or in their hand. It's an estimate derived from SeenCards,
+	// only set in training mode (see WithTrainingMode).
+	RemainingCardEstimate map[string]int `json:"remainingCardEstimate,omitempty"`
+
+	// WinProbability is a heuristic estimate, from 0 to 1, of your chances of winning the game (see
+	// GameState.WinProbability). It's only set in training mode (see WithTrainingMode).
+	WinProbability *float64 `json:"winProbability,omitempty"`
+
+	// KnockPreview previews the outcome of knocking, so clients can ask for confirmation before a
+	// risky knock. It's only set when you can knock.
+	KnockPreview *KnockPreview `json:"knockPreview"`
+
+	// IsYourTurn is true when the game is waiting for you to act, including confirming a finished
+	// round or a rematch. TurnReason says what the game is waiting for, e.g. TurnReasonDraw or
+	// TurnReasonConfirmRematch.
+	IsYourTurn bool   `json:"isYourTurn"`
+	TurnReason string `json:"turnReason"`
+
+	// TurnsUntilStockEmpty estimates how many more turns can draw from the draw pile before it runs
+	// out and the discard pile is reshuffled into it (see GameState.TurnsUntilStockEmpty).
+	TurnsUntilStockEmpty int `json:"turnsUntilStockEmpty"`
+
+	// GameResult is the final standings, only set once the game has ended.
+	GameResult *GameResult `json:"gameResult"`
+
+	// RoundSummary is the outcome of the round, only set once the round is finished.
+	RoundSummary *RoundSummary `json:"roundSummary"`
+
 	// LastActionLog is the log of the last action that was run in the current round. If the round has
 	// just started, this will be nil. Clients typically want to use this to show the current player
 	// what the opponent just did.
 	LastActionLog *ActionLog `json:"lastActionLog"`
 
 	RuleMaxPoints int `json:"ruleMaxPoints"`
+
+	// RuleIsSubtractiveScoring means scores count down from RuleMaxPoints, and zero wins.
+	RuleIsSubtractiveScoring bool `json:"ruleIsSubtractiveScoring"`
+
+	// RuleBestOf is the number of rounds in a best-of series (see WithBestOf), or zero.
+	RuleBestOf int `json:"ruleBestOf"`
+
+	// RuleAceWrap is whether runs may wrap around from the king to the ace (see WithAceWrap).
+	RuleAceWrap AceWrap `json:"ruleAceWrap"`
+
+	// RuleIsStrictDiscardDraw means the top of the discard pile may only be drawn if it completes a
+	// meld (see WithStrictDiscardDraw).
+	RuleIsStrictDiscardDraw bool `json:"ruleIsStrictDiscardDraw"`
+
+	// RuleOpeningDiscard is whether the opening discard may be taken on the first turn of a round
+	// (see WithOpeningDiscardRule).
+	RuleOpeningDiscard OpeningDiscardRule `json:"ruleOpeningDiscard"`
+
+	// IsOpeningDiscardOffered is true while the opening discard is offered to the turn player, who
+	// doesn't lead the round and must take or decline it (see OpeningDiscardOfferToBoth).
+	IsOpeningDiscardOffered bool `json:"isOpeningDiscardOffered"`
+
+	// RuleCut is how the deck is cut before dealing (see WithCut), and IsCutPending is true while
+	// the round waits for the player leading it to cut the deck (see CutByOpponent).
+	RuleCut      CutMode `json:"ruleCut"`
+	IsCutPending bool    `json:"isCutPending"`
+
+	// RuleCardValues maps card numbers to the deadwood points they're worth, where they differ from
+	// the default values (see WithCardValues).
+	RuleCardValues map[int]int `json:"ruleCardValues,omitempty"`
+
+	// EngineVersion is the version of the engine that produced this state (see Version).
+	EngineVersion string `json:"engineVersion,omitempty"`
 }
 
 type Bot interface {
@@ -0,0 +1,163 @@
+package chinchon
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// discardAndEndTurn discards the card and, if the player keeps the turn because they could knock
+// or meld, ends their turn.
+func discardAndEndTurn(t *testing.T, g *GameState, card Card) {
+	playerID := g.TurnPlayerID
+	require.NoError(t, g.RunAction(NewActionDiscardCard(card, playerID)))
+	if g.TurnPlayerID == playerID && !g.IsRoundFinished {
+		require.NoError(t, g.RunAction(NewActionEndTurn(playerID)))
+	}
+}
+
+func TestHandsDealtIsNotModifiedDuringTheRound(t *testing.T) {
+	gameState := New()
+	playerID := gameState.TurnPlayerID
+	dealt := append([]Card{}, gameState.Players[playerID].Hand.Revealed...)
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+	discardAndEndTurn(t, gameState, dealt[0])
+
+	require.Equal(t, dealt, gameState.RoundsLog[gameState.RoundNumber].HandsDealt[playerID].Revealed)
+}
+
+func TestWithDeckSizeIgnoresInvalidSizes(t *testing.T) {
+	for _, deckSize := range []int{-1, 0, 39, 44, 52} {
+		g := New(WithDeckSize(deckSize))
+		require.Equal(t, DefaultDeckSize, g.RuleDeckSize, deckSize)
+		require.Len(t, g.roundDeckOrder, DefaultDeckSize, deckSize)
+	}
+	require.Len(t, New(WithDeckSize(48)).roundDeckOrder, 48)
+}
+
+func TestWithHandSizeIgnoresSizesThatCannotBeDealt(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []func(*GameState)
+	}{
+		{name: "zero", opts: []func(*GameState){WithHandSize(0)}},
+		{name: "negative", opts: []func(*GameState){WithHandSize(-3)}},
+		{name: "larger than the deck", opts: []func(*GameState){WithHandSize(30)}},
+		{name: "too large for four players", opts: []func(*GameState){WithHandSize(11), WithPlayers(4)}},
+		{name: "leaving no cards to draw", opts: []func(*GameState){WithHandSize(20)}},
+		{name: "from rules", opts: Rules{HandSize: 25}.Options()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g *GameState
+			require.NotPanics(t, func() { g = New(tt.opts...) })
+			require.Equal(t, DefaultHandSize, g.RuleHandSize)
+			require.False(t, g.DrawPile.IsEmpty())
+		})
+	}
+	require.Equal(t, 10, New(WithHandSize(10), WithPlayers(3)).RuleHandSize)
+}
+
+func TestGinAndUndercutBonuses(t *testing.T) {
+	var (
+		gin = []Card{
+			{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}, {Suit: BASTO, Number: 1},
+			{Suit: ORO, Number: 2}, {Suit: COPA, Number: 2}, {Suit: ESPADA, Number: 2},
+		}
+		fiveDeadwood = []Card{
+			{Suit: ORO, Number: 10}, {Suit: ORO, Number: 11}, {Suit: ORO, Number: 12},
+			{Suit: COPA, Number: 10}, {Suit: COPA, Number: 11}, {Suit: COPA, Number: 12}, {Suit: BASTO, Number: 5},
+		}
+	)
+	tests := []struct {
+		name           string
+		opts           []func(*GameState)
+		knockerHand    []Card
+		opponentHand   []Card
+		knockerWins    bool
+		expectedPoints int
+	}{
+		{name: "default_gin_bonus", knockerHand: gin, knockerWins: true, expectedPoints: 67 + DefaultGinBonus},
+		{name: "no_gin_bonus", opts: []func(*GameState){WithGinBonus(0)}, knockerHand: gin, knockerWins: true, expectedPoints: 67},
+		{name: "custom_gin_bonus", opts: []func(*GameState){WithGinBonus(40)}, knockerHand: gin, knockerWins: true, expectedPoints: 67 + 40},
+		{name: "default_undercut_bonus", opponentHand: fiveDeadwood, expectedPoints: 2 + DefaultUndercutBonus},
+		{name: "no_undercut_bonus", opts: []func(*GameState){WithUndercutBonus(0)}, opponentHand: fiveDeadwood, expectedPoints: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(append([]func(*GameState){WithMaxPoints(1000)}, tt.opts...)...)
+			knocker, opponent := gameState.TurnPlayerID, gameState.TurnOpponentPlayerID
+			readyToKnock(gameState)
+			if tt.knockerHand != nil {
+				gameState.Players[knocker].Hand.Revealed = tt.knockerHand
+			}
+			gameState.Players[opponent].Hand.Revealed = []Card{
+				{Suit: ORO, Number: 10}, {Suit: COPA, Number: 11}, {Suit: ESPADA, Number: 12}, {Suit: BASTO, Number: 10},
+				{Suit: ORO, Number: 11}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 7},
+			}
+			if tt.opponentHand != nil {
+				gameState.Players[opponent].Hand.Revealed = tt.opponentHand
+			}
+			require.NoError(t, gameState.RunAction(NewActionKnock(knocker)))
+
+			winner := opponent
+			if tt.knockerWins {
+				winner = knocker
+			}
+			roundLog := gameState.RoundsLog[gameState.RoundNumber]
+			require.Equal(t, winner, roundLog.WinnerPlayerID)
+			require.Equal(t, tt.expectedPoints, roundLog.PointsAwarded)
+			require.Equal(t, tt.expectedPoints, gameState.Players[winner].Score)
+		})
+	}
+}
+
+func TestEqualDeadwoodRoundScore(t *testing.T) {
+	// Two sets and 7 deadwood points, like the knocker's hand (see readyToKnock)
+	sevenDeadwood := []Card{
+		{Suit: ORO, Number: 3}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 3},
+		{Suit: ORO, Number: 4}, {Suit: COPA, Number: 4}, {Suit: ESPADA, Number: 4},
+		{Suit: ORO, Number: 7},
+	}
+
+	for _, knocker := range []int{0, 1} {
+		t.Run(fmt.Sprintf("tie_with_knocker_%v", knocker), func(t *testing.T) {
+			gameState := New()
+			if gameState.TurnPlayerID != knocker {
+				gameState.changeTurn()
+			}
+			readyToKnock(gameState)
+			gameState.Players[gameState.OpponentOf(knocker)].Hand.Revealed = sevenDeadwood
+
+			require.NoError(t, gameState.RunAction(NewActionKnock(knocker)))
+
+			roundLog := gameState.RoundsLog[gameState.RoundNumber]
+			require.False(t, roundLog.IsDraw)
+			require.Equal(t, gameState.OpponentOf(knocker), roundLog.WinnerPlayerID)
+			require.Equal(t, knocker, roundLog.LoserPlayerID)
+			require.Equal(t, DefaultUndercutBonus, roundLog.PointsAwarded)
+			require.Equal(t, DefaultUndercutBonus, gameState.Players[gameState.OpponentOf(knocker)].Score)
+		})
+	}
+
+	t.Run("tie_with_no_knocker", func(t *testing.T) {
+		gameState := New()
+		readyToKnock(gameState)
+		gameState.Players[gameState.TurnOpponentPlayerID].Hand.Revealed = sevenDeadwood
+
+		gameState.calculateRoundScore()
+
+		roundLog := gameState.RoundsLog[gameState.RoundNumber]
+		require.True(t, roundLog.IsDraw)
+		require.Equal(t, -1, roundLog.WinnerPlayerID)
+		require.Equal(t, -1, roundLog.LoserPlayerID)
+		require.Equal(t, 7, roundLog.WinnerDeadwoodPoints)
+		require.Equal(t, 7, roundLog.LoserDeadwoodPoints)
+		require.Zero(t, roundLog.PointsAwarded)
+		require.Zero(t, gameState.Players[0].Score)
+		require.Zero(t, gameState.Players[1].Score)
+	})
+}
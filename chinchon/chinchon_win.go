@@ -0,0 +1,57 @@
+package chinchon
+
+// DefaultChinchonBonus is the bonus awarded to a round winner holding a chinchón.
+const DefaultChinchonBonus = 50
+
+// WithChinchonBonus sets the bonus awarded to a round winner holding a chinchón (see isChinchon),
+// instead of the gin bonus.
+func WithChinchonBonus(chinchonBonus int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleChinchonBonus = chinchonBonus
+	}
+}
+
+// WithChinchonEndsGame makes a chinchón (see isChinchon) win the whole game outright, as in many
+// house rules, rather than just the round.
+func WithChinchonEndsGame(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleChinchonEndsGame = enabled
+	}
+}
+
+// isChinchon returns true if the player's cards, melded or not, form a single run of the same suit
+// spanning the whole hand, e.g. 1 to 7 de oro. It doesn't matter whether the player laid it down as
+// one meld, split it into several, or didn't meld it at all.
+func (g GameState) isChinchon(playerID int) bool {
+	player := g.Players[playerID]
+	cards := append([]Card{}, player.Hand.cards()...)
+	for _, meld := range player.Melds {
+		for _, card := range meld.Cards {
+			if !containsCard(cards, card) {
+				cards = append(cards, card)
+			}
+		}
+	}
+	return len(cards) == g.RuleHandSize && isRun(cards, g.RuleAceWrap)
+}
+
+// chinchonPlayerID returns the player holding a chinchón, or -1 if neither does. Should both hold
+// one, the knocker's wins.
+func (g GameState) chinchonPlayerID() int {
+	chinchonPlayerID := -1
+	for _, playerID := range g.PlayerOrder {
+		if g.isChinchon(playerID) && (chinchonPlayerID == -1 || playerID == g.KnockedPlayerID) {
+			chinchonPlayerID = playerID
+		}
+	}
+	return chinchonPlayerID
+}
+
+func containsCard(cards []Card, card Card) bool {
+	for _, c := range cards {
+		if c == card {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChinchonWinsTheRound(t *testing.T) {
+	orosRun := oros(1, 2, 3, 4, 5, 6, 7)
+	tests := []struct {
+		name          string
+		opts          []func(*GameState)
+		hand          []Card
+		melds         []*Meld
+		isChinchon    bool
+		isGameEnded   bool
+		pointsAwarded int
+	}{
+		{name: "unmelded", hand: orosRun, isChinchon: true, pointsAwarded: 67 + DefaultChinchonBonus},
+		{name: "melded_as_one_meld", melds: []*Meld{{Type: MeldTypeRun, Cards: orosRun}}, isChinchon: true, pointsAwarded: 67 + DefaultChinchonBonus},
+		{
+			name:          "melded_as_two_melds",
+			hand:          oros(1),
+			melds:         []*Meld{{Type: MeldTypeRun, Cards: oros(2, 3, 4)}, {Type: MeldTypeRun, Cards: oros(5, 6, 7)}},
+			isChinchon:    true,
+			pointsAwarded: 67 + DefaultChinchonBonus,
+		},
+		{name: "custom_bonus", opts: []func(*GameState){WithChinchonBonus(200)}, hand: orosRun, isChinchon: true, pointsAwarded: 67 + 200},
+		{name: "ends_the_game", opts: []func(*GameState){WithChinchonEndsGame(true)}, hand: orosRun, isChinchon: true, isGameEnded: true, pointsAwarded: 67 + DefaultChinchonBonus},
+		{
+			name: "gin_without_a_chinchon",
+			opts: []func(*GameState){WithChinchonEndsGame(true)},
+			melds: []*Meld{
+				{Type: MeldTypeRun, Cards: oros(1, 2, 3, 4)},
+				{Type: MeldTypeSet, Cards: []Card{{Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}, {Suit: BASTO, Number: 1}}},
+			},
+			pointsAwarded: 67 + DefaultGinBonus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(append([]func(*GameState){WithMaxPoints(1000)}, tt.opts...)...)
+			knocker := gameState.TurnPlayerID
+			readyToKnock(gameState)
+			gameState.Players[knocker].Hand.Revealed = append([]Card{}, tt.hand...)
+			gameState.Players[knocker].Melds = tt.melds
+			gameState.Players[gameState.TurnOpponentPlayerID].Hand.Revealed = []Card{
+				{Suit: COPA, Number: 10}, {Suit: COPA, Number: 11}, {Suit: ESPADA, Number: 12}, {Suit: BASTO, Number: 10},
+				{Suit: ESPADA, Number: 11}, {Suit: BASTO, Number: 12}, {Suit: ORO, Number: 7},
+			}
+			require.NoError(t, gameState.RunAction(NewActionKnock(knocker)))
+
+			roundLog := gameState.RoundsLog[gameState.RoundNumber]
+			require.Equal(t, tt.isChinchon, roundLog.IsChinchon)
+			require.Equal(t, knocker, roundLog.WinnerPlayerID)
+			require.Equal(t, tt.pointsAwarded, roundLog.PointsAwarded)
+			require.Equal(t, tt.isGameEnded, gameState.IsGameEnded)
+			if tt.isGameEnded {
+				require.Equal(t, knocker, gameState.WinnerPlayerID)
+			}
+		})
+	}
+}
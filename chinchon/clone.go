@@ -0,0 +1,136 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"maps"
+)
+
+// Clone returns a deep copy of the game state, including its hidden information, so that bots can
+// simulate actions with RunAction on the copy without affecting the game, e.g. for lookahead.
+//
+// The copy shares the options the game was created with and its referee, which are never mutated.
+// Serialized actions are shared too, as they're never mutated once logged. Observers aren't copied,
+// as actions run on the copy don't happen in the game.
+func (g *GameState) Clone() *GameState {
+	c := *g
+
+	c.Players = make(map[int]*Player, len(g.Players))
+	for playerID, player := range g.Players {
+		c.Players[playerID] = &Player{
+			Hand:  cloneHand(player.Hand),
+			Melds: cloneMelds(player.Melds),
+			Score: player.Score,
+		}
+	}
+	c.PlayerOrder = append([]int(nil), g.PlayerOrder...)
+	if g.PossibleActions != nil {
+		c.PossibleActions = append(make([]json.RawMessage, 0, len(g.PossibleActions)), g.PossibleActions...)
+	}
+	c.DrawPile = clonePile(g.DrawPile)
+	c.DiscardPile = clonePile(g.DiscardPile)
+
+	if g.RoundsLog != nil {
+		c.RoundsLog = make([]*RoundLog, len(g.RoundsLog))
+		for i, roundLog := range g.RoundsLog {
+			c.RoundsLog[i] = cloneRoundLog(roundLog)
+		}
+	}
+	c.RoundFinishedConfirmedPlayerIDs = maps.Clone(g.RoundFinishedConfirmedPlayerIDs)
+	c.RematchConfirmedPlayerIDs = maps.Clone(g.RematchConfirmedPlayerIDs)
+	c.RuleStartingScores = maps.Clone(g.RuleStartingScores)
+	c.RuleCardValues = maps.Clone(g.RuleCardValues)
+	if g.Seed != nil {
+		seed := *g.Seed
+		c.Seed = &seed
+	}
+
+	if g.deck != nil {
+		d := *g.deck
+		d.cards = cloneCards(g.deck.cards)
+		d.order = cloneCards(g.deck.order)
+		d.dealHandFunc = d.defaultDealHand
+		c.deck = &d
+	}
+	c.roundDeckOrder = cloneCards(g.roundDeckOrder)
+	c.positionCounts = maps.Clone(g.positionCounts)
+	if g.drewFromDiscardCard != nil {
+		card := *g.drewFromDiscardCard
+		c.drewFromDiscardCard = &card
+	}
+	c.bestDeadwoods = map[int]bestDeadwood{}
+	c.observers = nil
+	return &c
+}
+
+// cloneCards returns a copy of the cards, keeping nil as nil.
+func cloneCards(cards []Card) []Card {
+	if cards == nil {
+		return nil
+	}
+	return append(make([]Card, 0, len(cards)), cards...)
+}
+
+func cloneHand(h *Hand) *Hand {
+	if h == nil {
+		return nil
+	}
+	return &Hand{
+		Unrevealed:             cloneCards(h.Unrevealed),
+		Revealed:               cloneCards(h.Revealed),
+		displayUnrevealedCards: append([]DisplayCard(nil), h.displayUnrevealedCards...),
+	}
+}
+
+func cloneMelds(melds []*Meld) []*Meld {
+	if melds == nil {
+		return nil
+	}
+	cloned := make([]*Meld, 0, len(melds))
+	for _, meld := range melds {
+		cloned = append(cloned, cloneMeld(meld))
+	}
+	return cloned
+}
+
+func cloneMeld(meld *Meld) *Meld {
+	return &Meld{Type: meld.Type, Cards: cloneCards(meld.Cards)}
+}
+
+func clonePile(p *Pile) *Pile {
+	if p == nil {
+		return nil
+	}
+	return &Pile{Cards: cloneCards(p.Cards)}
+}
+
+func cloneRoundLog(r *RoundLog) *RoundLog {
+	if r == nil {
+		return nil
+	}
+	c := *r
+	if r.HandsDealt != nil {
+		c.HandsDealt = make(map[int]*Hand, len(r.HandsDealt))
+		for playerID, hand := range r.HandsDealt {
+			c.HandsDealt[playerID] = cloneHand(hand)
+		}
+	}
+	if r.MeldsDealt != nil {
+		c.MeldsDealt = make(map[int][]*Meld, len(r.MeldsDealt))
+		for playerID, melds := range r.MeldsDealt {
+			c.MeldsDealt[playerID] = cloneMelds(melds)
+		}
+	}
+	c.InitialDiscardPile = cloneCards(r.InitialDiscardPile)
+	c.FinalDiscardPile = cloneCards(r.FinalDiscardPile)
+	c.DeckOrder = cloneCards(r.DeckOrder)
+	if r.DeclinedKnockPlayerIDs != nil {
+		c.DeclinedKnockPlayerIDs = append(make([]int, 0, len(r.DeclinedKnockPlayerIDs)), r.DeclinedKnockPlayerIDs...)
+	}
+	if r.ActionsLog != nil {
+		c.ActionsLog = append(make([]ActionLog, 0, len(r.ActionsLog)), r.ActionsLog...)
+	}
+	if r.VetoedActionsLog != nil {
+		c.VetoedActionsLog = append(make([]ActionLog, 0, len(r.VetoedActionsLog)), r.VetoedActionsLog...)
+	}
+	return &c
+}
@@ -0,0 +1,51 @@
+package chinchon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneIsIndependentOfTheOriginal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	gameState := New(WithSeed(1))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, gameState.RunAction(randomAction(rng, gameState)))
+	}
+	original, err := gameState.Serialize()
+	require.NoError(t, err)
+
+	clone := gameState.Clone()
+	cloned, err := clone.Serialize()
+	require.NoError(t, err)
+	require.JSONEq(t, string(original), string(cloned))
+
+	for i := 0; i < 10 && !clone.IsGameEnded; i++ {
+		require.NoError(t, clone.RunAction(randomAction(rng, clone)))
+	}
+	clone.DrawPile.Cards[0] = Card{}
+	clone.Players[0].Hand.Revealed[0] = Card{}
+	clone.RoundsLog[clone.RoundNumber].ActionsLog[0].PlayerID = -1
+
+	after, err := gameState.Serialize()
+	require.NoError(t, err)
+	require.Equal(t, original, after)
+}
+
+func TestClonePlaysOutLikeTheOriginal(t *testing.T) {
+	gameState := New(WithSeed(1))
+	clone := gameState.Clone()
+
+	rng, cloneRng := rand.New(rand.NewSource(2)), rand.New(rand.NewSource(2))
+	for i := 0; i < 200 && !gameState.IsGameEnded; i++ {
+		require.NoError(t, gameState.RunAction(randomAction(rng, gameState)))
+		require.NoError(t, clone.RunAction(randomAction(cloneRng, clone)))
+	}
+
+	original, err := gameState.Serialize()
+	require.NoError(t, err)
+	cloned, err := clone.Serialize()
+	require.NoError(t, err)
+	require.Equal(t, original, cloned)
+}
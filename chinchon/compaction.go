@@ -0,0 +1,40 @@
+package chinchon
+
+// WithCompactFinishedRounds makes the game compact the actions log of each round once it's
+// finished and the next one starts (see RoundLog.Compact). This reclaims memory in long games,
+// especially on servers hosting many of them.
+func WithCompactFinishedRounds(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleCompactFinishedRounds = enabled
+	}
+}
+
+// Compact replaces the round's detailed ActionsLog with its move notation. It's meant for
+// finished rounds: the live round must keep its detailed log. Use Actions to read the log back.
+//
+// Rounds with referee notes are left as they are, since move notation can't represent them.
+func (r *RoundLog) Compact() error {
+	if r.CompactActionsLog != "" || len(r.ActionsLog) == 0 {
+		return nil
+	}
+	for _, actionLog := range r.ActionsLog {
+		if actionLog.RefereeNote != "" {
+			return nil
+		}
+	}
+	notation, err := EncodeActionsLog(r.ActionsLog)
+	if err != nil {
+		return err
+	}
+	r.CompactActionsLog = notation
+	r.ActionsLog = nil
+	return nil
+}
+
+// Actions returns the round's actions log, decoding it if the round was compacted.
+func (r *RoundLog) Actions() ([]ActionLog, error) {
+	if r.CompactActionsLog == "" {
+		return r.ActionsLog, nil
+	}
+	return DecodeActionsLog(r.CompactActionsLog)
+}
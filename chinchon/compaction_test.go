@@ -0,0 +1,114 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// dealFirstRound redeals the first round using rng, so that the round plays out deterministically.
+func dealFirstRound(rng *rand.Rand, g *GameState) {
+	cards := spanishCards(g.RuleDeckSize)
+	rng.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+	g.Players[0].Hand = &Hand{Revealed: append([]Card{}, cards[:g.RuleHandSize]...)}
+	g.Players[1].Hand = &Hand{Revealed: append([]Card{}, cards[g.RuleHandSize:2*g.RuleHandSize]...)}
+	g.DiscardPile = &Pile{Cards: append([]Card{}, cards[2*g.RuleHandSize])}
+	g.DrawPile = &Pile{Cards: append([]Card{}, cards[2*g.RuleHandSize+1:]...)}
+	g.PossibleActions = _serializeActions(g.CalculatePossibleActions())
+}
+
+// playFirstRound plays random actions, but always knocks when possible, until the second round
+// starts.
+func playFirstRound(t *testing.T, rng *rand.Rand, g *GameState) {
+	for i := 0; g.RoundNumber == 1; i++ {
+		require.Less(t, i, 10000, "round didn't finish")
+		action := randomAction(rng, g)
+		if knock := NewActionKnock(g.TurnPlayerID); knock.IsPossible(*g) {
+			action = knock
+		}
+		require.NoError(t, g.RunAction(action))
+	}
+}
+
+func TestNotationRoundTrip(t *testing.T) {
+	actionsLog := []ActionLog{
+		{PlayerID: 1, Action: SerializeAction(NewActionDrawFromDrawPile(1))},
+		{PlayerID: 1, Action: SerializeAction(NewActionDiscardCard(Card{Suit: COPA, Number: 3}, 1))},
+		{PlayerID: 0, Action: SerializeAction(NewActionDrawFromDiscardPile(0))},
+		{PlayerID: 0, Action: SerializeAction(NewActionDiscardCard(Card{Suit: ESPADA, Number: 12}, 0))},
+		{PlayerID: 0, Action: SerializeAction(NewActionMeldCards([]Card{{Suit: BASTO, Number: 4}, {Suit: BASTO, Number: 5}, {Suit: BASTO, Number: 6}}, MeldTypeRun, 0))},
+		{PlayerID: 0, Action: SerializeAction(NewActionMeldCards([]Card{{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}}, MeldTypeSet, 0))},
+		{PlayerID: 0, Action: SerializeAction(NewActionKnock(0))},
+		{PlayerID: 1, Action: SerializeAction(NewActionEndTurn(1))},
+	}
+
+	notation, err := EncodeActionsLog(actionsLog)
+	require.NoError(t, err)
+	require.Equal(t, "1D 1X3c 0P 0X12e 0R4b,5b,6b 0S7o,7c,7e 0K 1E", notation)
+
+	decoded, err := DecodeActionsLog(notation)
+	require.NoError(t, err)
+	require.Equal(t, actionsLog, decoded)
+}
+
+func TestDecodeInvalidNotation(t *testing.T) {
+	for _, notation := range []string{"D", "0", "0Z", "0X", "0X13o", "0Xo", "0X3z", "0R4b,,6b"} {
+		_, err := DecodeActionsLog(notation)
+		require.ErrorIs(t, err, errInvalidNotation, notation)
+	}
+}
+
+func TestCompactedRoundReplaysIdentically(t *testing.T) {
+	var (
+		rng = rand.New(rand.NewSource(42))
+		g   = New(WithCompactFinishedRounds(true))
+	)
+	dealFirstRound(rng, g)
+
+	// Snapshot the start of the first round, so it can be replayed.
+	snapshot, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	playFirstRound(t, rng, g)
+
+	round := g.RoundsLog[1]
+	require.NotEmpty(t, round.CompactActionsLog)
+	require.Empty(t, round.ActionsLog)
+	require.Empty(t, g.RoundsLog[2].CompactActionsLog, "the live round must not be compacted")
+
+	actionsLog, err := round.Actions()
+	require.NoError(t, err)
+
+	var replay GameState
+	require.NoError(t, json.Unmarshal(snapshot, &replay))
+	for _, actionLog := range actionsLog {
+		action, err := DeserializeAction(actionLog.Action)
+		require.NoError(t, err)
+		require.Equal(t, actionLog.PlayerID, replay.TurnPlayerID)
+		require.NoError(t, replay.RunAction(action))
+	}
+
+	replayed := replay.RoundsLog[1]
+	require.Equal(t, actionsLog, replayed.ActionsLog)
+	require.Equal(t, round.WinnerPlayerID, replayed.WinnerPlayerID)
+	require.Equal(t, round.KnockedPlayerID, replayed.KnockedPlayerID)
+	require.Equal(t, round.PointsAwarded, replayed.PointsAwarded)
+	require.Equal(t, round.WinnerDeadwoodPoints, replayed.WinnerDeadwoodPoints)
+	require.Equal(t, round.LoserDeadwoodPoints, replayed.LoserDeadwoodPoints)
+	for playerID, player := range g.Players {
+		require.Equal(t, player.Score, replay.Players[playerID].Score)
+	}
+}
+
+func TestRoundsAreNotCompactedByDefault(t *testing.T) {
+	var (
+		rng = rand.New(rand.NewSource(42))
+		g   = New()
+	)
+	dealFirstRound(rng, g)
+	playFirstRound(t, rng, g)
+	require.Empty(t, g.RoundsLog[1].CompactActionsLog)
+	require.NotEmpty(t, g.RoundsLog[1].ActionsLog)
+}
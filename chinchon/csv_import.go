@@ -0,0 +1,92 @@
+package chinchon
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var errInvalidCSV = errors.New("invalid moves CSV")
+
+// csvHeader is the optional header row of a moves CSV.
+var csvHeader = []string{"round", "player", "notation"}
+
+// ImportedGame is a game's actions imported from a CSV of moves (see ImportCSV).
+type ImportedGame struct {
+	// Actions are the game's serialized actions in order, as Replay takes them.
+	Actions [][]byte
+
+	// Rounds and Lines are, for each action, the round it was written down for and the CSV line it
+	// was on, so that Replay can check the rounds and point at the offending line.
+	Rounds []int
+	Lines  []int
+}
+
+// ImportCSV reads a game's moves from CSV rows of round,player,notation, e.g. so that test games can
+// be authored in a spreadsheet. Each row holds one action in move notation, without the player ID
+// (see EncodeActionsLog), e.g. "1,0,X3c" for player 0 discarding the 3 de copa in round 1. A header
+// row is optional. Rounds start at 1 and can't go back or be skipped. Melds are written with commas,
+// so their notation must be quoted, e.g. 1,0,"R4b,5b,6b", which spreadsheets do when exporting CSV.
+//
+// Rows are only validated on their own; replaying the game checks that its actions are possible.
+func ImportCSV(r io.Reader) (ImportedGame, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+	reader.TrimLeadingSpace = true
+
+	game := ImportedGame{}
+	lastRound := 1
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return game, nil
+		}
+		if err != nil {
+			return ImportedGame{}, fmt.Errorf("%w: %v", errInvalidCSV, err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(game.Actions) == 0 && strings.EqualFold(row[0], csvHeader[0]) {
+			continue
+		}
+
+		round, err := strconv.Atoi(row[0])
+		if err != nil || round < lastRound || round > lastRound+1 {
+			return ImportedGame{}, fmt.Errorf("%w: line %v: round [%v] should be %v or %v", errInvalidCSV, line, row[0], lastRound, lastRound+1)
+		}
+		playerID, err := strconv.Atoi(row[1])
+		if err != nil || playerID < 0 {
+			return ImportedGame{}, fmt.Errorf("%w: line %v: invalid player [%v]", errInvalidCSV, line, row[1])
+		}
+		action, err := parseActionNotation(strings.TrimSpace(row[2]), playerID)
+		if err != nil {
+			return ImportedGame{}, fmt.Errorf("line %v: %w", line, err)
+		}
+
+		lastRound = round
+		game.Actions = append(game.Actions, SerializeAction(action))
+		game.Rounds = append(game.Rounds, round)
+		game.Lines = append(game.Lines, line)
+	}
+}
+
+// Replay rebuilds the imported game like Replay does, also checking that each action is played in
+// the round it was written down for.
+func (ig ImportedGame) Replay(seed int64, opts ...func(*GameState)) (*GameState, error) {
+	g := New(append([]func(*GameState){WithSeed(seed)}, opts...)...)
+	for i, bs := range ig.Actions {
+		if g.RoundNumber != ig.Rounds[i] {
+			return nil, fmt.Errorf("%w: line %v: action is in round %v, but the game is in round %v", errInvalidCSV, ig.Lines[i], ig.Rounds[i], g.RoundNumber)
+		}
+		action, err := DeserializeAction(bs)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %w", ig.Lines[i], err)
+		}
+		if err := g.RunAction(action); err != nil {
+			return nil, fmt.Errorf("line %v: %w", ig.Lines[i], err)
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,93 @@
+package chinchon
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportCSV(t *testing.T) {
+	gameState := New(WithSeed(42))
+	playerID := gameState.TurnPlayerID
+	card := gameState.Players[playerID].Hand.Revealed[0]
+
+	csv := fmt.Sprintf("round,player,notation\n1,%d,D\n1,%d,X%s\n", playerID, playerID, cardNotation(card))
+	imported, err := ImportCSV(strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 1}, imported.Rounds)
+	require.Equal(t, []int{2, 3}, imported.Lines)
+
+	replayed, err := imported.Replay(42)
+	require.NoError(t, err)
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+	require.NoError(t, gameState.RunAction(NewActionDiscardCard(card, playerID)))
+	require.Equal(t, gameState.Players, replayed.Players)
+	require.Equal(t, gameState.DiscardPile, replayed.DiscardPile)
+}
+
+func TestImportCSVReplaysAWholeGame(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	gameState := New(WithSeed(7))
+	var (
+		csvBuf strings.Builder
+		writer = csv.NewWriter(&csvBuf)
+	)
+	for i := 0; i < 500 && !gameState.IsGameEnded; i++ {
+		action := randomAction(rng, gameState)
+		notation, err := actionNotation(action)
+		require.NoError(t, err)
+		require.NoError(t, writer.Write([]string{strconv.Itoa(gameState.RoundNumber), strconv.Itoa(action.GetPlayerID()), notation}))
+		require.NoError(t, gameState.RunAction(action))
+	}
+	require.Greater(t, gameState.RoundNumber, 1)
+	writer.Flush()
+
+	imported, err := ImportCSV(strings.NewReader(csvBuf.String()))
+	require.NoError(t, err)
+	replayed, err := imported.Replay(7)
+	require.NoError(t, err)
+
+	expected, err := gameState.Serialize()
+	require.NoError(t, err)
+	actual, err := replayed.Serialize()
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual))
+}
+
+func TestImportInvalidCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		expected string
+	}{
+		{name: "missing_field", csv: "1,0,D\n1,0\n", expected: "line 2"},
+		{name: "invalid_round", csv: "1,0,D\nx,0,X3c\n", expected: "line 2: round [x]"},
+		{name: "skipped_round", csv: "1,0,D\n3,0,X3c\n", expected: "line 2: round [3]"},
+		{name: "round_going_back", csv: "1,0,C\n2,0,D\n1,0,X3c\n", expected: "line 3: round [1]"},
+		{name: "invalid_player", csv: "round,player,notation\n1,-1,D\n", expected: "line 2: invalid player [-1]"},
+		{name: "invalid_notation", csv: "1,0,D\n1,0,X13o\n", expected: "line 2: invalid move notation"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ImportCSV(strings.NewReader(tt.csv))
+			require.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
+
+func TestImportedGameChecksRoundsWhenReplayed(t *testing.T) {
+	gameState := New(WithSeed(42))
+	csv := fmt.Sprintf("1,%d,D\n2,%d,X%s\n", gameState.TurnPlayerID, gameState.TurnPlayerID, cardNotation(gameState.Players[gameState.TurnPlayerID].Hand.Revealed[0]))
+
+	imported, err := ImportCSV(strings.NewReader(csv))
+	require.NoError(t, err)
+	_, err = imported.Replay(42)
+	require.ErrorIs(t, err, errInvalidCSV)
+	require.ErrorContains(t, err, "line 2")
+}
@@ -0,0 +1,36 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateDeadwoodPoints(t *testing.T) {
+	hand := []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+		{Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 10}, {Suit: BASTO, Number: 12},
+	}
+	run := &Meld{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}}}
+
+	require.Equal(t, 33, GameState{}.calculateDeadwoodPoints(hand, nil))
+	require.Equal(t, 27, GameState{}.calculateDeadwoodPoints(hand, []*Meld{run}))
+	require.Equal(t, 0, GameState{}.calculateDeadwoodPoints(nil, []*Meld{run}))
+}
+
+func BenchmarkCalculateDeadwoodPoints(b *testing.B) {
+	hand := []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}, {Suit: ORO, Number: 4},
+		{Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 7},
+		{Suit: ESPADA, Number: 10},
+	}
+	melds := []*Meld{
+		{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}, {Suit: ORO, Number: 4}}},
+		{Type: MeldTypeSet, Cards: []Card{{Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 7}}},
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		GameState{}.calculateDeadwoodPoints(hand, melds)
+	}
+}
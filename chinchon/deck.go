@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 )
 
 const (
@@ -47,9 +48,40 @@ func (c Card) String() string {
 	return fmt.Sprintf("%d de %s", c.Number, c.Suit)
 }
 
+// DeadwoodValue returns the points the card is worth as deadwood by default: its number from 1 to 7,
+// or 10 for higher cards. Games may override it (see WithCardValues).
+func (c Card) DeadwoodValue() int {
+	if c.Number >= 1 && c.Number <= 7 {
+		return c.Number
+	}
+	return 10
+}
+
+// Compare orders cards by suit in deck order (see SuitOrder), then by number. It returns a negative
+// number if the card goes before the other, a positive one if it goes after, and 0 if they're equal,
+// so that cards can be sorted deterministically with slices.SortFunc.
+func (c Card) Compare(other Card) int {
+	if c.Suit != other.Suit {
+		return SuitOrder(c.Suit) - SuitOrder(other.Suit)
+	}
+	return c.Number - other.Number
+}
+
+// SuitOrder returns the position of the suit in deck order: oro, copa, espada, basto. Unknown suits
+// return -1.
+func SuitOrder(suit string) int {
+	return slices.Index([]string{ORO, COPA, ESPADA, BASTO}, suit)
+}
+
 type deck struct {
 	cards        []Card
 	dealHandFunc func() *Hand
+
+	// rng shuffles the deck when set (see WithSeed); otherwise the global source is used.
+	rng *rand.Rand
+
+	// order, when set, is used as is instead of the next shuffle (see NewFromDeck).
+	order []Card
 }
 
 // Hand represents a player's hand. Cards can be revealed or unrevealed.
@@ -78,6 +110,15 @@ func (h Hand) DeepCopy() Hand {
 	}
 }
 
+// cards returns the cards in the hand, or nil if the hand is nil (e.g. before the first round is
+// dealt).
+func (h *Hand) cards() []Card {
+	if h == nil {
+		return nil
+	}
+	return h.Revealed
+}
+
 func (h Hand) HasUnrevealedCard(c Card) bool {
 	for _, card := range h.Unrevealed {
 		if card == c {
@@ -159,19 +200,33 @@ var (
 	errCardAlreadyRevealed = errors.New("card already revealed")
 )
 
-func makeSpanishCards() []Card {
+// DefaultDeckSize is the number of cards in the Spanish deck used by default (without 8s and 9s).
+const DefaultDeckSize = 40
+
+// spanishCards returns the cards of the Spanish deck, in suit and number order. A deck size of
+// 48 includes the 8s and 9s; any other size results in the default 40-card deck.
+func spanishCards(deckSize int) []Card {
 	cards := []Card{}
 	suits := []string{ORO, COPA, ESPADA, BASTO}
 	for _, suit := range suits {
 		for i := 1; i <= 12; i++ {
-			if i == 8 || i == 9 {
+			if (i == 8 || i == 9) && deckSize != 48 {
 				continue
 			}
 			cards = append(cards, Card{Suit: suit, Number: i})
 		}
 	}
+	return cards
+}
+
+func makeSpanishCards(deckSize int, rng *rand.Rand) []Card {
+	cards := spanishCards(deckSize)
 
-	rand.Shuffle(len(cards), func(i, j int) {
+	shuffle := rand.Shuffle
+	if rng != nil {
+		shuffle = rng.Shuffle
+	}
+	shuffle(len(cards), func(i, j int) {
 		cards[i], cards[j] = cards[j], cards[i]
 	})
 
@@ -179,13 +234,17 @@ func makeSpanishCards() []Card {
 }
 
 func newDeck() *deck {
-	d := deck{cards: makeSpanishCards()}
+	d := deck{cards: makeSpanishCards(DefaultDeckSize, nil)}
 	d.dealHandFunc = d.defaultDealHand
 	return &d
 }
 
-func (d *deck) shuffle() {
-	d.cards = makeSpanishCards()
+func (d *deck) shuffle(deckSize int) {
+	if d.order != nil {
+		d.cards, d.order = append([]Card{}, d.order...), nil
+		return
+	}
+	d.cards = makeSpanishCards(deckSize, d.rng)
 }
 
 func (d *deck) dealHand() *Hand {
@@ -0,0 +1,46 @@
+package chinchon
+
+import "math/rand"
+
+// CutMode is how the deck is cut after shuffling, before dealing each round.
+type CutMode int
+
+const (
+	// CutNone deals straight from the shuffled deck. It's the default.
+	CutNone CutMode = iota
+
+	// CutRandom cuts the deck at a random position. Cutting a shuffled deck doesn't change the odds,
+	// but it's closer to how the game is played at a table.
+	CutRandom
+
+	// CutByOpponent lets the dealer's opponent, who leads the round, choose where to cut the deck
+	// (see ActionCutDeck) before anything is dealt, so that they have a say in a deck they didn't
+	// shuffle, e.g. as part of a commit-reveal fairness flow.
+	CutByOpponent
+)
+
+// WithCut sets how the deck is cut before dealing each round (see CutMode).
+func WithCut(mode CutMode) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleCut = mode
+	}
+}
+
+// cutDeck moves the cards before position to the back of the deck, so that dealing starts at the
+// card at position. The round's deck order is updated to match, as it's the order cards are dealt
+// in.
+func (g *GameState) cutDeck(position int) {
+	cards := g.deck.cards
+	g.deck.cards = append(append([]Card{}, cards[position:]...), cards[:position]...)
+	g.roundDeckOrder = append([]Card{}, g.deck.cards...)
+}
+
+// randomCutPosition returns a position to cut the deck at, leaving at least a card on either side
+// of the cut. Seeded games cut from the round's source, so that they stay reproducible.
+func (g *GameState) randomCutPosition() int {
+	intn := rand.Intn
+	if g.deck.rng != nil {
+		intn = g.deck.rng.Intn
+	}
+	return 1 + intn(len(g.deck.cards)-1)
+}
@@ -0,0 +1,103 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireDealtFrom fails unless the round was dealt from the given deck order.
+func requireDealtFrom(t *testing.T, g *GameState, order []Card) {
+	t.Helper()
+	for i := 0; i < g.RuleHandSize; i++ {
+		require.Equal(t, order[2*i], g.Players[g.PlayerOrder[0]].Hand.Revealed[i])
+		require.Equal(t, order[2*i+1], g.Players[g.PlayerOrder[1]].Hand.Revealed[i])
+	}
+	dealt := 2 * g.RuleHandSize
+	require.Equal(t, order[dealt:len(order)-1], g.DrawPile.Cards)
+	require.Equal(t, order[len(order)-1:], g.DiscardPile.Cards)
+}
+
+func TestOpponentCutDealsFromTheCut(t *testing.T) {
+	order := spanishCards(DefaultDeckSize)
+	g, err := NewFromDeck(order, WithCut(CutByOpponent))
+	require.NoError(t, err)
+	leader := g.TurnPlayerID
+
+	require.True(t, g.IsCutPending)
+	require.Empty(t, g.Players[0].Hand.Revealed)
+	require.True(t, g.DrawPile.IsEmpty())
+	isYourTurn, turnReason := g.turnReason(leader)
+	require.True(t, isYourTurn)
+	require.Equal(t, TurnReasonCutDeck, turnReason)
+	require.Len(t, possibleActionNames(g), len(order)-1)
+	require.Error(t, g.RunAction(NewActionDrawFromDrawPile(leader)))
+
+	require.NoError(t, g.RunAction(NewActionCutDeck(10, leader)))
+
+	require.False(t, g.IsCutPending)
+	requireDealtFrom(t, g, append(append([]Card{}, order[10:]...), order[:10]...))
+	require.Equal(t, leader, g.TurnPlayerID)
+	require.Equal(t, 1, g.RoundTurnNumber)
+	require.Equal(t, []string{DRAW_FROM_DRAW_PILE, DRAW_FROM_DISCARD_PILE}, possibleActionNames(g))
+	require.Equal(t, g.Players[leader].Hand.Revealed, g.RoundsLog[1].HandsDealt[leader].Revealed)
+}
+
+func TestOpponentCutMustLeaveCardsOnEitherSide(t *testing.T) {
+	g := New(WithCut(CutByOpponent))
+	leader := g.TurnPlayerID
+
+	require.False(t, NewActionCutDeck(0, leader).IsPossible(*g))
+	require.False(t, NewActionCutDeck(g.RuleDeckSize, leader).IsPossible(*g))
+	require.False(t, NewActionCutDeck(1, g.TurnOpponentPlayerID).IsPossible(*g))
+	require.True(t, NewActionCutDeck(g.RuleDeckSize-1, leader).IsPossible(*g))
+}
+
+func TestNoCutDealsFromTheShuffle(t *testing.T) {
+	order := spanishCards(DefaultDeckSize)
+	g, err := NewFromDeck(order, WithCut(CutNone))
+	require.NoError(t, err)
+
+	require.False(t, g.IsCutPending)
+	requireDealtFrom(t, g, order)
+}
+
+func TestRandomCutIsARotationOfTheShuffle(t *testing.T) {
+	uncut := New(WithSeed(3))
+	cut := New(WithSeed(3), WithCut(CutRandom))
+	require.Equal(t, cut.roundDeckOrder, New(WithSeed(3), WithCut(CutRandom)).roundDeckOrder)
+
+	shuffled := uncut.roundDeckOrder
+	position := 0
+	for position < len(shuffled) && shuffled[position] != cut.roundDeckOrder[0] {
+		position++
+	}
+	require.Greater(t, position, 0)
+	require.Equal(t, append(append([]Card{}, shuffled[position:]...), shuffled[:position]...), cut.roundDeckOrder)
+	requireDealtFrom(t, cut, cut.roundDeckOrder)
+}
+
+func TestPendingCutSurvivesSerialization(t *testing.T) {
+	g := New(WithSeed(5), WithCut(CutByOpponent))
+	bs, err := g.Serialize()
+	require.NoError(t, err)
+	restored, err := Deserialize(bs)
+	require.NoError(t, err)
+
+	require.NoError(t, g.RunAction(NewActionCutDeck(7, g.TurnPlayerID)))
+	require.NoError(t, restored.RunAction(NewActionCutDeck(7, restored.TurnPlayerID)))
+	require.Equal(t, g.Players, restored.Players)
+	require.Equal(t, g.DrawPile, restored.DrawPile)
+}
+
+func TestCutDeckSurvivesNotation(t *testing.T) {
+	actionsLog := []ActionLog{{PlayerID: 0, Action: SerializeAction(NewActionCutDeck(12, 0))}}
+
+	notation, err := EncodeActionsLog(actionsLog)
+	require.NoError(t, err)
+	require.Equal(t, "0T12", notation)
+
+	decoded, err := DecodeActionsLog(notation)
+	require.NoError(t, err)
+	require.Equal(t, actionsLog, decoded)
+}
@@ -0,0 +1,39 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeckOrderIsRevealedWhenRoundFinishes(t *testing.T) {
+	gameState := New()
+	roundLog := gameState.RoundsLog[gameState.RoundNumber]
+
+	// Draw a few cards from the draw pile, which come off the back of the deck.
+	drawn := []Card{}
+	for i := 0; i < 4; i++ {
+		playerID := gameState.TurnPlayerID
+		card, err := gameState.DrawPile.TopCard()
+		require.NoError(t, err)
+		drawn = append(drawn, card)
+
+		require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+		discardAndEndTurn(t, gameState, card)
+		require.Nil(t, roundLog.DeckOrder, "the deck order must not leak mid-round")
+	}
+
+	readyToKnock(gameState)
+	require.NoError(t, gameState.RunAction(NewActionKnock(gameState.TurnPlayerID)))
+
+	deckOrder := roundLog.DeckOrder
+	require.Len(t, deckOrder, DefaultDeckSize)
+	for i := 0; i < DefaultHandSize; i++ {
+		require.Equal(t, deckOrder[2*i], roundLog.HandsDealt[0].Revealed[i])
+		require.Equal(t, deckOrder[2*i+1], roundLog.HandsDealt[1].Revealed[i])
+	}
+	require.Equal(t, []Card{deckOrder[len(deckOrder)-1]}, roundLog.InitialDiscardPile)
+	for i, card := range drawn {
+		require.Equal(t, deckOrder[len(deckOrder)-2-i], card)
+	}
+}
@@ -2,6 +2,7 @@ package chinchon
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -155,8 +156,34 @@ func TestDeadwoodPoints(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := calculateDeadwoodPoints(tt.hand, tt.melds)
+			result := GameState{}.calculateDeadwoodPoints(tt.hand, tt.melds)
 			assert.Equal(t, tt.expectedPoints, result)
 		})
 	}
 }
+
+func TestCard_DeadwoodValue(t *testing.T) {
+	for number, expected := range map[int]int{1: 1, 5: 5, 7: 7, 8: 10, 10: 10, 12: 10} {
+		assert.Equal(t, expected, Card{Suit: BASTO, Number: number}.DeadwoodValue(), "number %v", number)
+	}
+}
+
+func TestCard_Compare(t *testing.T) {
+	cards := []Card{
+		{Suit: BASTO, Number: 1},
+		{Suit: ORO, Number: 12},
+		{Suit: ESPADA, Number: 3},
+		{Suit: ORO, Number: 2},
+		{Suit: COPA, Number: 7},
+	}
+	slices.SortFunc(cards, Card.Compare)
+
+	assert.Equal(t, []Card{
+		{Suit: ORO, Number: 2},
+		{Suit: ORO, Number: 12},
+		{Suit: COPA, Number: 7},
+		{Suit: ESPADA, Number: 3},
+		{Suit: BASTO, Number: 1},
+	}, cards)
+	assert.Equal(t, 0, Card{Suit: COPA, Number: 4}.Compare(Card{Suit: COPA, Number: 4}))
+}
@@ -0,0 +1,27 @@
+package chinchon
+
+import "slices"
+
+// WithDeclinedKnockPenalty discourages stalling: a player who could have knocked during a round,
+// but ended their turn instead, and then loses the round, concedes penalty extra points to the
+// winner. A penalty of zero (the default) disables the rule.
+func WithDeclinedKnockPenalty(penalty int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleDeclinedKnockPenalty = penalty
+	}
+}
+
+// recordDeclinedKnock records in the current round's log that the player ended a turn when they
+// could have knocked.
+func (g *GameState) recordDeclinedKnock(playerID int) {
+	roundLog := g.RoundsLog[g.RoundNumber]
+	if !slices.Contains(roundLog.DeclinedKnockPlayerIDs, playerID) {
+		roundLog.DeclinedKnockPlayerIDs = append(roundLog.DeclinedKnockPlayerIDs, playerID)
+	}
+}
+
+// declinedKnock returns true if the player ended a turn of the current round when they could
+// have knocked.
+func (g GameState) declinedKnock(playerID int) bool {
+	return slices.Contains(g.RoundsLog[g.RoundNumber].DeclinedKnockPlayerIDs, playerID)
+}
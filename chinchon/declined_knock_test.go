@@ -0,0 +1,81 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// endTurnAfterDiscarding makes the turn player end their turn after drawing and discarding, able
+// to knock if canKnock.
+func endTurnAfterDiscarding(t *testing.T, g *GameState, canKnock bool) {
+	if canKnock {
+		readyToKnock(g)
+	} else {
+		g.Players[g.TurnPlayerID].Hand.Revealed = []Card{
+			{Suit: ORO, Number: 10}, {Suit: COPA, Number: 11}, {Suit: ESPADA, Number: 12}, {Suit: BASTO, Number: 10},
+			{Suit: ORO, Number: 11}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 10},
+		}
+		g.HasDrawnThisTurn = true
+		g.HasDiscardedThisTurn = true
+	}
+	require.NoError(t, g.RunAction(NewActionEndTurn(g.TurnPlayerID)))
+}
+
+func TestDeclinedKnockPenalty(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           []func(*GameState)
+		canKnock       bool
+		expectedPoints int
+	}{
+		{name: "declined_knock_is_penalized", opts: []func(*GameState){WithDeclinedKnockPenalty(15)}, canKnock: true, expectedPoints: 75},
+		{name: "ending_turn_without_knock_is_not_penalized", opts: []func(*GameState){WithDeclinedKnockPenalty(15)}, canKnock: false, expectedPoints: 60},
+		{name: "disabled_by_default", canKnock: true, expectedPoints: 60},
+		{name: "penalized_when_only_melds_and_knocks_are_logged", opts: []func(*GameState){WithDeclinedKnockPenalty(15), WithActionLogging(ActionLoggingMeldsAndKnocks)}, canKnock: true, expectedPoints: 75},
+		{name: "penalized_when_no_actions_are_logged", opts: []func(*GameState){WithDeclinedKnockPenalty(15), WithActionLogging(ActionLoggingNone)}, canKnock: true, expectedPoints: 75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+			decliner := gameState.TurnPlayerID
+
+			endTurnAfterDiscarding(t, gameState, tt.canKnock)
+			knockWinningRound(t, gameState)
+
+			round := gameState.RoundsLog[gameState.RoundNumber]
+			require.Equal(t, decliner, round.LoserPlayerID)
+			require.Equal(t, tt.expectedPoints, round.PointsAwarded)
+		})
+	}
+}
+
+func TestDeclinedKnockPenaltyOnlyAppliesToTheLoser(t *testing.T) {
+	gameState := New(WithDeclinedKnockPenalty(15))
+	winner := gameState.TurnPlayerID
+
+	// The winner declines a knock, then the loser ends their turn, and the winner knocks later on.
+	endTurnAfterDiscarding(t, gameState, true)
+	endTurnAfterDiscarding(t, gameState, false)
+	require.Equal(t, winner, gameState.TurnPlayerID)
+	knockWinningRound(t, gameState)
+
+	require.Equal(t, winner, gameState.RoundsLog[gameState.RoundNumber].WinnerPlayerID)
+	require.Equal(t, 60, gameState.RoundsLog[gameState.RoundNumber].PointsAwarded)
+}
+
+func TestDeclinedKnockSurvivesNotation(t *testing.T) {
+	actionsLog := []ActionLog{
+		{PlayerID: 0, Action: SerializeAction(&ActionEndTurn{act: act{Name: END_TURN, PlayerID: 0}, DeclinedKnock: true})},
+		{PlayerID: 1, Action: SerializeAction(NewActionEndTurn(1))},
+	}
+
+	notation, err := EncodeActionsLog(actionsLog)
+	require.NoError(t, err)
+	require.Equal(t, "0EK 1E", notation)
+
+	decoded, err := DecodeActionsLog(notation)
+	require.NoError(t, err)
+	require.Equal(t, actionsLog, decoded)
+}
@@ -0,0 +1,131 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DiffStates describes every exported field that differs between two game states, one per line,
+// e.g. "Players[0].Hand.Revealed[3]: 1 de oro != 2 de oro". It returns an empty string if they
+// are equal.
+//
+// It's meant for test assertions, so that failures point at what differs rather than dumping both
+// states.
+func DiffStates(a, b *GameState) string {
+	diffs := []string{}
+	diffValues("GameState", reflect.ValueOf(a), reflect.ValueOf(b), &diffs)
+	return strings.Join(diffs, "\n")
+}
+
+var (
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	rawJSONType  = reflect.TypeOf(json.RawMessage{})
+)
+
+// diffValues appends a line to diffs for each difference between a and b, which have the same type,
+// recursing into pointers, structs, maps and slices.
+func diffValues(path string, a, b reflect.Value, diffs *[]string) {
+	if a.Type() == rawJSONType {
+		if string(a.Bytes()) != string(b.Bytes()) {
+			*diffs = append(*diffs, fmt.Sprintf("%v: %s != %s", path, a.Bytes(), b.Bytes()))
+		}
+		return
+	}
+	if a.Type().Implements(stringerType) && a.Kind() != reflect.Pointer {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*diffs = append(*diffs, fmt.Sprintf("%v: %v != %v", path, a.Interface(), b.Interface()))
+		}
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				*diffs = append(*diffs, fmt.Sprintf("%v: %v != %v", path, describeNil(a), describeNil(b)))
+			}
+			return
+		}
+		diffValues(path, a.Elem(), b.Elem(), diffs)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if field := a.Type().Field(i); field.IsExported() {
+				diffValues(path+"."+field.Name, a.Field(i), b.Field(i), diffs)
+			}
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(a, b) {
+			keyPath := fmt.Sprintf("%v[%v]", path, key.Interface())
+			aValue, bValue := a.MapIndex(key), b.MapIndex(key)
+			switch {
+			case !aValue.IsValid():
+				*diffs = append(*diffs, fmt.Sprintf("%v: <missing> != %v", keyPath, describe(bValue)))
+			case !bValue.IsValid():
+				*diffs = append(*diffs, fmt.Sprintf("%v: %v != <missing>", keyPath, describe(aValue)))
+			default:
+				diffValues(keyPath, aValue, bValue, diffs)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			*diffs = append(*diffs, fmt.Sprintf("%v: length %v != %v", path, a.Len(), b.Len()))
+		}
+		for i := 0; i < max(a.Len(), b.Len()); i++ {
+			indexPath := fmt.Sprintf("%v[%v]", path, i)
+			switch {
+			case i >= a.Len():
+				*diffs = append(*diffs, fmt.Sprintf("%v: <missing> != %v", indexPath, describe(b.Index(i))))
+			case i >= b.Len():
+				*diffs = append(*diffs, fmt.Sprintf("%v: %v != <missing>", indexPath, describe(a.Index(i))))
+			default:
+				diffValues(indexPath, a.Index(i), b.Index(i), diffs)
+			}
+		}
+	default:
+		if a.CanInterface() && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*diffs = append(*diffs, fmt.Sprintf("%v: %v != %v", path, a.Interface(), b.Interface()))
+		}
+	}
+}
+
+// sortedMapKeys returns the keys in either map, sorted so that the diff is deterministic.
+func sortedMapKeys(a, b reflect.Value) []reflect.Value {
+	keys := []reflect.Value{}
+	seen := map[any]bool{}
+	for _, m := range []reflect.Value{a, b} {
+		for _, key := range m.MapKeys() {
+			if !seen[key.Interface()] {
+				seen[key.Interface()] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].CanInt() {
+			return keys[i].Int() < keys[j].Int()
+		}
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// describe formats a value that's only present on one side of the diff.
+func describe(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Type() == rawJSONType {
+		return string(v.Bytes())
+	}
+	return fmt.Sprintf("%+v", v.Interface())
+}
+
+func describeNil(v reflect.Value) string {
+	if v.IsNil() {
+		return "nil"
+	}
+	return describe(v)
+}
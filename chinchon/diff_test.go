@@ -0,0 +1,46 @@
+package chinchon
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffStatesOfIdenticalStatesIsEmpty(t *testing.T) {
+	a, b := New(WithSeed(1)), New(WithSeed(1))
+	require.NoError(t, a.RunAction(NewActionDrawFromDrawPile(a.TurnPlayerID)))
+	require.NoError(t, b.RunAction(NewActionDrawFromDrawPile(b.TurnPlayerID)))
+
+	require.Empty(t, DiffStates(a, b))
+	require.Empty(t, DiffStates(a, a))
+}
+
+func TestDiffStatesReportsASingleCardDifference(t *testing.T) {
+	a, b := New(WithSeed(1)), New(WithSeed(1))
+	original := a.Players[0].Hand.Revealed[2]
+	replacement := b.DrawPile.Cards[0]
+	b.Players[0].Hand.Revealed[2] = replacement
+
+	require.Equal(t, "GameState.Players[0].Hand.Revealed[2]: "+original.String()+" != "+replacement.String(), DiffStates(a, b))
+}
+
+func TestDiffStatesReportsEveryDifference(t *testing.T) {
+	a, b := New(WithSeed(1)), New(WithSeed(1))
+	b.Players[1].Score = 20
+	b.TurnPlayerID, b.TurnOpponentPlayerID = b.TurnOpponentPlayerID, b.TurnPlayerID
+	b.IsRoundFinished = true
+	card, err := b.DrawPile.DrawCard()
+	require.NoError(t, err)
+	b.Players[1].Melds = append(b.Players[1].Melds, &Meld{Cards: []Card{card}, Type: MeldTypeSet})
+
+	drawn := len(b.DrawPile.Cards)
+	require.Equal(t, fmt.Sprintf(`GameState.TurnPlayerID: 1 != 0
+GameState.TurnOpponentPlayerID: 0 != 1
+GameState.Players[1].Melds: length 0 != 1
+GameState.Players[1].Melds[0]: <missing> != {Type:set Cards:[%v]}
+GameState.Players[1].Score: 0 != 20
+GameState.DrawPile.Cards: length %v != %v
+GameState.DrawPile.Cards[%v]: %v != <missing>
+GameState.IsRoundFinished: false != true`, card, drawn+1, drawn, drawn, card), DiffStates(a, b))
+}
@@ -0,0 +1,17 @@
+package chinchon
+
+// DiscardsBy returns the cards the player has discarded this round, in the order they were
+// discarded, e.g. to show what an opponent has been throwing away.
+//
+// Cards are listed even if the opponent later drew them from the discard pile, so they may no
+// longer be on it. It's based on the round's ActionsLog, so it's empty unless every action is
+// logged (see WithActionLogging).
+func (g GameState) DiscardsBy(playerID int) []Card {
+	discards := []Card{}
+	for _, action := range _deserializeCurrentRoundActionsByPlayerID(playerID, g) {
+		if discard, ok := action.(*ActionDiscardCard); ok {
+			discards = append(discards, discard.Card)
+		}
+	}
+	return discards
+}
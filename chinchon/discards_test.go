@@ -0,0 +1,43 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscardsByReconstructsEachPlayersDiscards(t *testing.T) {
+	g := New()
+	you, them := g.TurnPlayerID, g.TurnOpponentPlayerID
+
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(you)))
+	yourFirst := g.Players[you].Hand.Revealed[0]
+	discardAndEndTurn(t, g, yourFirst)
+
+	// They pick up your discard, so it's no longer on the discard pile.
+	require.NoError(t, g.RunAction(NewActionDrawFromDiscardPile(them)))
+	theirFirst := g.Players[them].Hand.Revealed[0]
+	discardAndEndTurn(t, g, theirFirst)
+
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(you)))
+	yourSecond := g.Players[you].Hand.Revealed[0]
+	discardAndEndTurn(t, g, yourSecond)
+
+	require.NotContains(t, g.DiscardPile.Cards, yourFirst)
+	require.Equal(t, []Card{yourFirst, yourSecond}, g.DiscardsBy(you))
+	require.Equal(t, []Card{theirFirst}, g.DiscardsBy(them))
+}
+
+func TestDiscardsByOnlyCoversTheCurrentRound(t *testing.T) {
+	g := New()
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+	discardAndEndTurn(t, g, g.Players[g.TurnPlayerID].Hand.Revealed[0])
+	require.Len(t, g.DiscardsBy(g.TurnOpponentPlayerID), 1)
+
+	knockWinningRound(t, g)
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(0)))
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(1)))
+
+	require.Empty(t, g.DiscardsBy(0))
+	require.Empty(t, g.DiscardsBy(1))
+}
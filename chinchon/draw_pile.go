@@ -0,0 +1,39 @@
+package chinchon
+
+import "math/rand"
+
+// TurnsUntilStockEmpty estimates how many more turns can draw from the draw pile before it runs
+// out, assuming every turn draws one card from it, so that players can be warned to make their
+// melds in time. The turn that draws the last card counts. Drawing from the discard pile makes the
+// draw pile last longer than estimated.
+//
+// Once the draw pile runs out, the discard pile is reshuffled into it (see replenishDrawPile), so
+// this is also when the next reshuffle is due.
+func (g GameState) TurnsUntilStockEmpty() int {
+	return len(g.DrawPile.cards())
+}
+
+// replenishDrawPile reshuffles the discard pile back into the draw pile once the draw pile is
+// exhausted, so that long rounds can't run out of cards to draw. The top of the discard pile stays
+// where it is, so it can still be drawn. Nothing happens if the discard pile has no other cards.
+func (g *GameState) replenishDrawPile() {
+	if !g.DrawPile.IsEmpty() || len(g.DiscardPile.cards()) < 2 {
+		return
+	}
+	top, _ := g.DiscardPile.DrawCard()
+	cards := g.DiscardPile.Cards
+
+	// Seeded games derive each reshuffle from the seed, so that they stay reproducible (see WithSeed)
+	// without depending on the state of the deck's source, which isn't serialized.
+	roundLog := g.RoundsLog[g.RoundNumber]
+	roundLog.DrawPileReshuffles++
+	roundLog.LastReshuffleActionCount = len(roundLog.ActionsLog)
+	shuffle := rand.Shuffle
+	if rng := g.seededRand(g.RoundNumber, roundLog.DrawPileReshuffles); rng != nil {
+		shuffle = rng.Shuffle
+	}
+	shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+
+	g.DrawPile = &Pile{Cards: cards}
+	g.DiscardPile = &Pile{Cards: []Card{top}}
+}
@@ -0,0 +1,84 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscardPileIsReshuffledIntoExhaustedDrawPile(t *testing.T) {
+	gameState := New()
+	pileCards := len(gameState.DrawPile.Cards) + len(gameState.DiscardPile.Cards)
+
+	// Leave a single card to draw, moving the rest under the discard pile.
+	drawPile := gameState.DrawPile.Cards
+	gameState.DrawPile.Cards = drawPile[len(drawPile)-1:]
+	gameState.DiscardPile.Cards = append(append([]Card{}, drawPile[:len(drawPile)-1]...), gameState.DiscardPile.Cards...)
+
+	playerID := gameState.TurnPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+	require.True(t, gameState.DrawPile.IsEmpty(), "the draw pile is only replenished at the start of a turn")
+	discarded := gameState.Players[playerID].Hand.Revealed[0]
+	discardAndEndTurn(t, gameState, discarded)
+
+	require.Equal(t, []Card{discarded}, gameState.DiscardPile.Cards)
+	require.Len(t, gameState.DrawPile.Cards, pileCards-1)
+	require.True(t, NewActionDrawFromDrawPile(gameState.TurnPlayerID).IsPossible(*gameState))
+}
+
+func TestReplenishDrawPileWithTinyPiles(t *testing.T) {
+	var (
+		top   = Card{Suit: ORO, Number: 1}
+		other = Card{Suit: COPA, Number: 2}
+	)
+	tests := []struct {
+		name             string
+		discardPile      []Card
+		expectedDraw     []Card
+		expectedDiscards []Card
+	}{
+		{name: "empty_discard_pile", discardPile: []Card{}, expectedDraw: []Card{}, expectedDiscards: []Card{}},
+		{name: "only_the_top_card", discardPile: []Card{top}, expectedDraw: []Card{}, expectedDiscards: []Card{top}},
+		{name: "one_card_under_the_top", discardPile: []Card{other, top}, expectedDraw: []Card{other}, expectedDiscards: []Card{top}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New()
+			gameState.DrawPile = &Pile{Cards: []Card{}}
+			gameState.DiscardPile = &Pile{Cards: tt.discardPile}
+
+			gameState.replenishDrawPile()
+
+			require.Equal(t, tt.expectedDraw, gameState.DrawPile.Cards)
+			require.Equal(t, tt.expectedDiscards, gameState.DiscardPile.Cards)
+		})
+	}
+}
+
+func TestTurnsUntilStockEmpty(t *testing.T) {
+	gameState := New()
+	require.Equal(t, len(gameState.DrawPile.Cards), gameState.TurnsUntilStockEmpty())
+
+	// Leave two cards to draw, moving the rest under the discard pile, which will be reshuffled into
+	// the draw pile once it runs out.
+	drawPile := gameState.DrawPile.Cards
+	gameState.DrawPile.Cards = drawPile[len(drawPile)-2:]
+	gameState.DiscardPile.Cards = append(append([]Card{}, drawPile[:len(drawPile)-2]...), gameState.DiscardPile.Cards...)
+	require.Equal(t, 2, gameState.TurnsUntilStockEmpty())
+	require.Equal(t, 2, gameState.ToClientGameState(0).TurnsUntilStockEmpty)
+
+	playerID := gameState.TurnPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+	require.Equal(t, 1, gameState.TurnsUntilStockEmpty())
+	discardAndEndTurn(t, gameState, gameState.Players[playerID].Hand.Revealed[0])
+
+	playerID = gameState.TurnPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(playerID)))
+	require.Equal(t, 0, gameState.TurnsUntilStockEmpty())
+	discardedCount := len(gameState.DiscardPile.Cards) + 1
+	discardAndEndTurn(t, gameState, gameState.Players[playerID].Hand.Revealed[0])
+
+	// The next turn starts with the discard pile reshuffled in, all but its top card
+	require.Equal(t, discardedCount-1, gameState.TurnsUntilStockEmpty())
+}
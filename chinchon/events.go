@@ -0,0 +1,90 @@
+package chinchon
+
+// EventType is what happened in the game (see GameState.AddObserver).
+type EventType string
+
+// Event types, in the order they're emitted when an action causes several of them.
+const (
+	EventCardDrawn     EventType = "card_drawn"
+	EventCardDiscarded EventType = "card_discarded"
+	EventMeldLaid      EventType = "meld_laid"
+	EventCardLaidOff   EventType = "card_laid_off"
+	EventKnocked       EventType = "knocked"
+	EventRoundFinished EventType = "round_finished"
+	EventRoundStarted  EventType = "round_started"
+	EventGameEnded     EventType = "game_ended"
+)
+
+// Event is something that happened while running an action, so that servers and UIs can notify
+// players of what changed without diffing game states.
+type Event struct {
+	Type EventType `json:"type"`
+
+	// PlayerID is the player who drew, discarded, melded, laid off or knocked. For a round that
+	// finished or a game that ended, it's the winner, or -1 if there's none; for a round that
+	// started, it's the player to act first.
+	PlayerID int `json:"playerID"`
+
+	// RoundNumber is the round the event happened in.
+	RoundNumber int `json:"roundNumber"`
+
+	// Card is the card drawn, discarded or laid off. A card drawn from the draw pile is only known
+	// to the player who drew it, so it mustn't be shown to their opponent.
+	Card *Card `json:"card,omitempty"`
+
+	// IsFromDiscardPile is true if the card was drawn from the discard pile.
+	IsFromDiscardPile bool `json:"isFromDiscardPile,omitempty"`
+
+	// Meld is the meld laid, or the meld a card was laid off onto, as it is afterwards.
+	Meld *Meld `json:"meld,omitempty"`
+
+	// PointsAwarded is the number of points the winner of a finished round was awarded.
+	PointsAwarded int `json:"pointsAwarded,omitempty"`
+}
+
+// AddObserver adds a function that's called with every event that happens while running actions,
+// in order, right after the action that caused it has run. Observers mustn't run actions
+// themselves.
+//
+// Rounds started by New aren't observed, as there are no observers yet. Clones don't keep the
+// observers, so that simulating actions on them isn't reported as happening in the game.
+func (g *GameState) AddObserver(observer func(Event)) {
+	g.observers = append(g.observers, observer)
+}
+
+// emit calls the observers, if any, with the event.
+func (g *GameState) emit(event Event) {
+	for _, observer := range g.observers {
+		observer(event)
+	}
+}
+
+// emitActionEvent emits the event of the action that was just run, if it has one.
+func (g *GameState) emitActionEvent(action Action) {
+	if len(g.observers) == 0 {
+		return
+	}
+	event := Event{PlayerID: action.GetPlayerID(), RoundNumber: g.RoundNumber}
+	switch a := action.(type) {
+	case *ActionDrawFromDrawPile, *ActionDrawFromDiscardPile:
+		hand := g.Players[a.GetPlayerID()].Hand.Revealed
+		card := hand[len(hand)-1]
+		event.Type, event.Card = EventCardDrawn, &card
+		event.IsFromDiscardPile = a.GetName() == DRAW_FROM_DISCARD_PILE
+	case *ActionDiscardCard:
+		card := a.Card
+		event.Type, event.Card = EventCardDiscarded, &card
+	case *ActionMeldCards:
+		melds := g.Players[a.PlayerID].Melds
+		event.Type, event.Meld = EventMeldLaid, cloneMeld(melds[len(melds)-1])
+	case *ActionLayOffCard:
+		card := a.Card
+		event.Type, event.Card = EventCardLaidOff, &card
+		event.Meld = cloneMeld(g.Players[a.MeldPlayerID].Melds[a.MeldIndex])
+	case *ActionKnock:
+		event.Type = EventKnocked
+	default:
+		return
+	}
+	g.emit(event)
+}
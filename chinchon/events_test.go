@@ -0,0 +1,85 @@
+package chinchon
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedRoundGame returns a game with four card hands where player 1, who leads, can meld three
+// 1s and knock with the 2 de basto they draw after discarding the 5 de basto.
+func scriptedRoundGame(t *testing.T, opts ...func(*GameState)) *GameState {
+	leaderHand := []Card{{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}, {Suit: BASTO, Number: 5}}
+	opponentHand := []Card{{Suit: ORO, Number: 2}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 4}, {Suit: BASTO, Number: 6}}
+	drawn, initialDiscard := Card{Suit: BASTO, Number: 2}, Card{Suit: BASTO, Number: 12}
+
+	deckOrder := []Card{}
+	for i := range leaderHand {
+		deckOrder = append(deckOrder, opponentHand[i], leaderHand[i])
+	}
+	for _, card := range spanishCards(DefaultDeckSize) {
+		if !slices.Contains(deckOrder, card) && card != drawn && card != initialDiscard {
+			deckOrder = append(deckOrder, card)
+		}
+	}
+	// The draw pile is drawn from the back, after the initial discard.
+	deckOrder = append(deckOrder, drawn, initialDiscard)
+
+	g, err := NewFromDeck(deckOrder, append([]func(*GameState){WithHandSize(4)}, opts...)...)
+	require.NoError(t, err)
+	require.Equal(t, 1, g.TurnPlayerID)
+	return g
+}
+
+func TestEventsOfAScriptedRound(t *testing.T) {
+	g := scriptedRoundGame(t)
+	events := []Event{}
+	g.AddObserver(func(event Event) { events = append(events, event) })
+
+	ones := []Card{{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}}
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(1)))
+	require.NoError(t, g.RunAction(NewActionDiscardCard(Card{Suit: BASTO, Number: 5}, 1)))
+	require.NoError(t, g.RunAction(NewActionMeldCards(ones, MeldTypeSet, 1)))
+	require.NoError(t, g.RunAction(NewActionKnock(1)))
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(1)))
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(0)))
+
+	require.Equal(t, []Event{
+		{Type: EventCardDrawn, PlayerID: 1, RoundNumber: 1, Card: &Card{Suit: BASTO, Number: 2}},
+		{Type: EventCardDiscarded, PlayerID: 1, RoundNumber: 1, Card: &Card{Suit: BASTO, Number: 5}},
+		{Type: EventMeldLaid, PlayerID: 1, RoundNumber: 1, Meld: &Meld{Type: MeldTypeSet, Cards: ones}},
+		{Type: EventKnocked, PlayerID: 1, RoundNumber: 1},
+		{Type: EventRoundFinished, PlayerID: 1, RoundNumber: 1, PointsAwarded: 13},
+		{Type: EventRoundStarted, PlayerID: 1, RoundNumber: 2},
+	}, events)
+}
+
+func TestEventsOfTheLastRound(t *testing.T) {
+	g := scriptedRoundGame(t, WithMaxPoints(5))
+	events := []Event{}
+	g.AddObserver(func(event Event) { events = append(events, event) })
+
+	require.NoError(t, g.RunAction(NewActionDrawFromDiscardPile(1)))
+	require.NoError(t, g.RunAction(NewActionDiscardCard(Card{Suit: BASTO, Number: 5}, 1)))
+	require.NoError(t, g.RunAction(NewActionKnock(1)))
+
+	require.Equal(t, []Event{
+		{Type: EventCardDrawn, PlayerID: 1, RoundNumber: 1, Card: &Card{Suit: BASTO, Number: 12}, IsFromDiscardPile: true},
+		{Type: EventCardDiscarded, PlayerID: 1, RoundNumber: 1, Card: &Card{Suit: BASTO, Number: 5}},
+		{Type: EventKnocked, PlayerID: 1, RoundNumber: 1},
+		{Type: EventRoundFinished, PlayerID: 1, RoundNumber: 1, PointsAwarded: 5},
+		{Type: EventGameEnded, PlayerID: 1, RoundNumber: 1},
+	}, events)
+}
+
+func TestClonesDontNotifyObservers(t *testing.T) {
+	g := New()
+	events := []Event{}
+	g.AddObserver(func(event Event) { events = append(events, event) })
+
+	require.NoError(t, g.Clone().RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+	require.Empty(t, events)
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+	require.Len(t, events, 1)
+}
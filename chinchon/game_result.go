@@ -0,0 +1,27 @@
+package chinchon
+
+// GameResult is the outcome of an ended game: everything an end-of-game screen needs.
+type GameResult struct {
+	// WinnerPlayerID is the player who won the game, or -1 if it ended without a winner, e.g. when
+	// a stall was detected.
+	WinnerPlayerID int `json:"winnerPlayerID"`
+
+	// RoundsPlayed is the number of finished rounds, including draws.
+	RoundsPlayed int `json:"roundsPlayed"`
+
+	// Players maps each player ID to their final score and stats.
+	Players map[int]PlayerStats `json:"players"`
+}
+
+// gameResult returns the outcome of the game, or nil if the game hasn't ended.
+func (g *GameState) gameResult() *GameResult {
+	if !g.IsGameEnded {
+		return nil
+	}
+	stats := ComputeStats(g)
+	return &GameResult{
+		WinnerPlayerID: g.WinnerPlayerID,
+		RoundsPlayed:   stats.RoundsPlayed,
+		Players:        stats.Players,
+	}
+}
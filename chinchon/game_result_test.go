@@ -0,0 +1,34 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameResultIsOnlySetOnceTheGameEnds(t *testing.T) {
+	gameState := New()
+	winner := gameState.TurnPlayerID
+
+	knockWinningRound(t, gameState)
+	require.False(t, gameState.IsGameEnded)
+	for playerID := range gameState.Players {
+		require.Nil(t, gameState.ToClientGameState(playerID).GameResult)
+	}
+
+	// Both players confirm, and the same player wins the next round, reaching 120 points.
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(gameState.TurnPlayerID)))
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(gameState.TurnPlayerID)))
+	gameState.TurnPlayerID, gameState.TurnOpponentPlayerID = winner, gameState.OpponentOf(winner)
+	knockWinningRound(t, gameState)
+	require.True(t, gameState.IsGameEnded)
+
+	for playerID := range gameState.Players {
+		result := gameState.ToClientGameState(playerID).GameResult
+		require.NotNil(t, result)
+		require.Equal(t, winner, result.WinnerPlayerID)
+		require.Equal(t, 2, result.RoundsPlayed)
+		require.Equal(t, PlayerStats{Score: DefaultMaxPoints, RoundsWon: 2, PointsWon: 120}, result.Players[winner])
+		require.Equal(t, PlayerStats{}, result.Players[gameState.OpponentOf(winner)])
+	}
+}
@@ -0,0 +1,56 @@
+package chinchon
+
+// GinCards returns the cards that, if drawn by the player (followed by the right discard), would
+// bring their hand to zero deadwood, i.e. the cards they need to go gin.
+//
+// Candidates are all cards in the deck except those the player knows to be elsewhere: in their
+// own hand, in any meld on the table, or buried in the discard pile (the top discard can still
+// be drawn, so it is a candidate).
+func (g GameState) GinCards(playerID int) []Card {
+	hand := g.Players[playerID].Hand.cards()
+	known := g.unavailableCards(playerID)
+
+	ginCards := []Card{}
+	for _, candidate := range spanishCards(g.RuleDeckSize) {
+		if known[candidate] {
+			continue
+		}
+		if g.isGinAfterDrawing(hand, candidate) {
+			ginCards = append(ginCards, candidate)
+		}
+	}
+	return ginCards
+}
+
+// unavailableCards returns the cards the player knows can't be drawn: those in their own hand, in
+// any meld on the table, or buried in the discard pile.
+func (g GameState) unavailableCards(playerID int) map[Card]bool {
+	unavailable := map[Card]bool{}
+	for _, card := range g.Players[playerID].Hand.cards() {
+		unavailable[card] = true
+	}
+	for _, player := range g.Players {
+		for _, meld := range player.Melds {
+			for _, card := range meld.Cards {
+				unavailable[card] = true
+			}
+		}
+	}
+	for i := 0; i < len(g.DiscardPile.cards())-1; i++ {
+		unavailable[g.DiscardPile.cards()[i]] = true
+	}
+	return unavailable
+}
+
+// isGinAfterDrawing returns true if, after adding drawn to the hand, some discard leaves a hand
+// that can be fully melded.
+func (g GameState) isGinAfterDrawing(hand []Card, drawn Card) bool {
+	newHand := append(append([]Card{}, hand...), drawn)
+	for i := range newHand {
+		rest := append(append([]Card{}, newHand[:i]...), newHand[i+1:]...)
+		if _, deadwood := g.bestMeldPartition(rest); deadwood == 0 {
+			return true
+		}
+	}
+	return false
+}
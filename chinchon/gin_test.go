@@ -0,0 +1,60 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinCardsOneCardAway(t *testing.T) {
+	gameState := New()
+	gameState.Players[0].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+		{Suit: COPA, Number: 5}, {Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 5},
+		{Suit: ORO, Number: 12},
+	}
+	gameState.DiscardPile.Cards = []Card{{Suit: COPA, Number: 10}}
+
+	require.Equal(t, []Card{{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}}, gameState.GinCards(0))
+}
+
+func TestGinCardsExcludesKnownCards(t *testing.T) {
+	gameState := New()
+	gameState.Players[0].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+		{Suit: COPA, Number: 5}, {Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 5},
+		{Suit: ORO, Number: 12},
+	}
+	// The 4 de oro is buried in the discard pile, and the 5 de oro is in the opponent's meld.
+	gameState.DiscardPile.Cards = []Card{{Suit: ORO, Number: 4}, {Suit: COPA, Number: 10}}
+	gameState.Players[1].Melds = []*Meld{{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7}}}}
+
+	require.Empty(t, gameState.GinCards(0))
+}
+
+func TestGinCardsIncludesTopDiscard(t *testing.T) {
+	gameState := New()
+	gameState.Players[0].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+		{Suit: COPA, Number: 5}, {Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 5},
+		{Suit: ORO, Number: 12},
+	}
+	gameState.DiscardPile.Cards = []Card{{Suit: COPA, Number: 10}, {Suit: ORO, Number: 4}}
+
+	require.Equal(t, []Card{{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}}, gameState.GinCards(0))
+}
+
+func TestBestMeldPartitionPrefersFewerDeadwood(t *testing.T) {
+	gameState := New()
+	// Taking the set of 3s leaves 1, 2, 4 and 5 de oro as deadwood (12). The best arrangement is
+	// the run 1-2-3-4-5 de oro, leaving the copa and espada 3s as deadwood (6).
+	hand := []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}, {Suit: ORO, Number: 4},
+		{Suit: ORO, Number: 5}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 3},
+	}
+
+	melds, deadwood := gameState.bestMeldPartition(hand)
+	require.Equal(t, 6, deadwood)
+	require.Len(t, melds, 1)
+	require.Equal(t, MeldTypeRun, melds[0].Type)
+}
@@ -0,0 +1,130 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireConcealed fails if any card in the opponent's hand that the player hasn't seen appears
+// anywhere in the player's client game state, other than the finished round's summary.
+func requireConcealed(t *testing.T, g *GameState, playerID int) {
+	t.Helper()
+	cgs := g.ToClientGameState(playerID)
+	// A finished round's summary reveals both hands by design, so it's left out.
+	summaryless := cgs
+	summaryless.RoundSummary = nil
+	bs, err := json.Marshal(summaryless)
+	require.NoError(t, err)
+
+	seen := map[Card]bool{}
+	for _, card := range g.seenCards(playerID) {
+		seen[card] = true
+	}
+	opponentHand := g.Players[g.OpponentOf(playerID)].Hand.Revealed
+	for _, card := range opponentHand {
+		if seen[card] {
+			continue
+		}
+		cardJSON, err := json.Marshal(card)
+		require.NoError(t, err)
+		require.NotContains(t, string(bs), string(cardJSON), "player %v can see %v in their state", playerID, card)
+	}
+	require.Equal(t, len(opponentHand), cgs.TheirHandCount)
+}
+
+// requireIndistinguishable fails if the player's client game state changes when the cards they
+// haven't seen in their opponents' hands are swapped with cards they haven't seen in the draw pile,
+// i.e. if any of its fields is derived from the concealed cards, e.g. their deadwood points.
+func requireIndistinguishable(t *testing.T, g *GameState, playerID int) {
+	t.Helper()
+	if g.IsRoundFinished || g.IsGameEnded {
+		return // Hands are revealed once the round is finished
+	}
+
+	seen := map[Card]bool{}
+	for _, card := range g.seenCards(playerID) {
+		seen[card] = true
+	}
+	swapped := g.Clone()
+	drawPile := swapped.DrawPile.Cards
+	for _, opponentID := range g.seatingFrom(playerID)[1:] {
+		hand := swapped.Players[opponentID].Hand.Revealed
+		for i := range hand {
+			if seen[hand[i]] {
+				continue
+			}
+			for j := range drawPile {
+				if !seen[drawPile[j]] {
+					hand[i], drawPile[j] = drawPile[j], hand[i]
+					seen[hand[i]], seen[drawPile[j]] = true, true // Swap each card once
+					break
+				}
+			}
+		}
+	}
+
+	expected, err := json.Marshal(g.ToClientGameState(playerID))
+	require.NoError(t, err)
+	actual, err := json.Marshal(swapped.ToClientGameState(playerID))
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual), "player %v's state depends on their opponents' concealed cards", playerID)
+}
+
+func TestOpponentHandIsConcealed(t *testing.T) {
+	for _, players := range []int{2, 3} {
+		t.Run(fmt.Sprintf("%v_players", players), func(t *testing.T) {
+			var (
+				rng = rand.New(rand.NewSource(42))
+				g   = New(WithSeed(42), WithPlayers(players), WithTrainingMode(true))
+			)
+			for i := 0; i < 300 && !g.IsGameEnded; i++ {
+				for playerID := range g.Players {
+					requireConcealed(t, g, playerID)
+					requireIndistinguishable(t, g, playerID)
+				}
+				require.NoError(t, g.RunAction(randomAction(rng, g)))
+			}
+		})
+	}
+}
+
+func TestOpponentHandIsVisibleWithOpenHands(t *testing.T) {
+	g := New(WithOpenHands(true))
+
+	bs, err := json.Marshal(g.ToClientGameState(0))
+	require.NoError(t, err)
+	for _, card := range g.Players[1].Hand.Revealed {
+		cardJSON, err := json.Marshal(card)
+		require.NoError(t, err)
+		require.Contains(t, string(bs), string(cardJSON))
+	}
+}
+
+func TestHandCountsFollowDrawsAndDiscards(t *testing.T) {
+	gameState := New()
+	you, them := gameState.TurnPlayerID, gameState.TurnOpponentPlayerID
+	requireHandCounts := func(yours, theirs int) {
+		t.Helper()
+		cgs := gameState.ToClientGameState(you)
+		require.Equal(t, yours, cgs.YourHandCount)
+		require.Equal(t, theirs, cgs.TheirHandCount)
+		cgs = gameState.ToClientGameState(them)
+		require.Equal(t, theirs, cgs.YourHandCount)
+		require.Equal(t, yours, cgs.TheirHandCount)
+	}
+
+	requireHandCounts(DefaultHandSize, DefaultHandSize)
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(you)))
+	requireHandCounts(DefaultHandSize+1, DefaultHandSize)
+
+	discardAndEndTurn(t, gameState, gameState.Players[you].Hand.Revealed[0])
+	requireHandCounts(DefaultHandSize, DefaultHandSize)
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDiscardPile(them)))
+	requireHandCounts(DefaultHandSize, DefaultHandSize+1)
+}
@@ -0,0 +1,57 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// knockWinningRound makes the turn player knock with 7 deadwood points against an opponent
+// holding 67, winning the round by 60 points.
+func knockWinningRound(t *testing.T, g *GameState) {
+	readyToKnock(g)
+	g.Players[g.TurnOpponentPlayerID].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 10}, {Suit: COPA, Number: 11}, {Suit: ESPADA, Number: 12}, {Suit: BASTO, Number: 10},
+		{Suit: ORO, Number: 11}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 7},
+	}
+	require.NoError(t, g.RunAction(NewActionKnock(g.TurnPlayerID)))
+}
+
+func TestStartingScoresInitializePlayers(t *testing.T) {
+	gameState := New(WithStartingScores(map[int]int{1: 40}))
+
+	require.Equal(t, 0, gameState.Players[0].Score)
+	require.Equal(t, 40, gameState.Players[1].Score)
+}
+
+func TestHandicappedGameEndsSoonerForTheDisadvantagedPlayer(t *testing.T) {
+	even := New()
+	handicapped := New(WithStartingScores(map[int]int{even.TurnPlayerID: 50}))
+
+	knockWinningRound(t, even)
+	knockWinningRound(t, handicapped)
+
+	require.False(t, even.IsGameEnded)
+	require.True(t, handicapped.IsGameEnded)
+	require.Equal(t, handicapped.TurnPlayerID, handicapped.WinnerPlayerID)
+}
+
+func TestInvalidStartingScoresAreIgnored(t *testing.T) {
+	gameState := New(WithStartingScores(map[int]int{0: DefaultMaxPoints, 1: -5, 2: 10}))
+
+	require.Equal(t, 0, gameState.Players[0].Score)
+	require.Equal(t, 0, gameState.Players[1].Score)
+	require.Len(t, gameState.Players, 2)
+}
+
+func TestStartingScoresAreValidatedAgainstMaxPoints(t *testing.T) {
+	gameState := New(WithStartingScores(map[int]int{0: 60}), WithMaxPoints(50))
+
+	require.Equal(t, 0, gameState.Players[0].Score)
+}
+
+func TestRematchKeepsStartingScores(t *testing.T) {
+	gameState := New(WithStartingScores(map[int]int{1: 40}))
+
+	require.Equal(t, 40, gameState.Rematch().Players[1].Score)
+}
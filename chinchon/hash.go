@@ -0,0 +1,29 @@
+package chinchon
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Hash returns a hash of the game position: the round, whose turn it is and how far into it they
+// are, every player's hand, melds and score, and both piles. Positions that only differ in history
+// (e.g. the actions log or the turn number) hash the same, and the order of cards in a hand
+// doesn't matter.
+func (g GameState) Hash() uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d|%d|%t|%t|%t|%d|%t|", g.RoundNumber, g.TurnPlayerID, g.HasDrawnThisTurn, g.HasDiscardedThisTurn, g.IsRoundFinished, g.KnockedPlayerID, g.IsGameEnded)
+	for _, playerID := range sortedKeys(g.Players) {
+		player := g.Players[playerID]
+		hand := []Card{}
+		if player.Hand != nil {
+			hand = append(hand, player.Hand.Revealed...)
+		}
+		sortCardsBySuitAndNumber(hand)
+		fmt.Fprintf(h, "%d|%v|%d|", playerID, hand, player.Score)
+		for _, meld := range player.Melds {
+			fmt.Fprintf(h, "%v%v|", meld.Type, meld.Cards)
+		}
+	}
+	fmt.Fprintf(h, "%v|%v", g.DrawPile.cards(), g.DiscardPile.cards())
+	return h.Sum64()
+}
@@ -0,0 +1,66 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialDiscardCount(t *testing.T) {
+	tests := []struct {
+		name                 string
+		opts                 []func(*GameState)
+		expectedDiscardCount int
+		canDrawFromDiscard   bool
+	}{
+		{name: "default", expectedDiscardCount: 1, canDrawFromDiscard: true},
+		{name: "none", opts: []func(*GameState){WithInitialDiscardCount(0)}, expectedDiscardCount: 0, canDrawFromDiscard: false},
+		{name: "two", opts: []func(*GameState){WithInitialDiscardCount(2)}, expectedDiscardCount: 2, canDrawFromDiscard: true},
+		{name: "negative_is_ignored", opts: []func(*GameState){WithInitialDiscardCount(-1)}, expectedDiscardCount: 1, canDrawFromDiscard: true},
+		{name: "whole_stock_is_ignored", opts: []func(*GameState){WithInitialDiscardCount(26)}, expectedDiscardCount: 1, canDrawFromDiscard: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+
+			require.Len(t, gameState.DiscardPile.Cards, tt.expectedDiscardCount)
+			require.Len(t, gameState.DrawPile.Cards, DefaultDeckSize-2*DefaultHandSize-tt.expectedDiscardCount)
+			require.Equal(t, gameState.DiscardPile.Cards, gameState.RoundsLog[1].InitialDiscardPile)
+			require.Equal(t, tt.canDrawFromDiscard, NewActionDrawFromDiscardPile(gameState.TurnPlayerID).IsPossible(*gameState))
+		})
+	}
+}
+
+func TestInitialDiscardCountAppliesToEveryRound(t *testing.T) {
+	gameState := New(WithInitialDiscardCount(2))
+
+	knockWinningRound(t, gameState)
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(gameState.TurnPlayerID)))
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(gameState.TurnPlayerID)))
+
+	require.Equal(t, 2, gameState.RoundNumber)
+	require.Len(t, gameState.DiscardPile.Cards, 2)
+	require.Len(t, gameState.DrawPile.Cards, DefaultDeckSize-2*DefaultHandSize-2)
+}
+
+func TestInitialDiscardCountThatDoesNotFitKeepsTheHandSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		handSize int
+		count    int
+	}{
+		{name: "too_many_for_the_hands", handSize: 10, count: 30},
+		{name: "no_cards_left_to_draw", handSize: 19, count: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(WithHandSize(tt.handSize), WithInitialDiscardCount(tt.count))
+
+			require.Equal(t, tt.handSize, gameState.RuleHandSize)
+			require.Equal(t, DefaultInitialDiscardCount, gameState.RuleInitialDiscardCount)
+			require.False(t, gameState.DrawPile.IsEmpty())
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package chinchon
+
+// KnockPreview is an advisory preview of the outcome of knocking right now. It doesn't affect
+// whether knocking is possible.
+type KnockPreview struct {
+	// DeadwoodPoints are the knocking player's deadwood points if they knocked now.
+	DeadwoodPoints int `json:"deadwoodPoints"`
+
+	// EstimatedOpponentDeadwoodPoints is a rough estimate of the opponent's deadwood points, based
+	// only on what the knocking player knows (see GameState.estimateOpponentDeadwood).
+	EstimatedOpponentDeadwoodPoints int `json:"estimatedOpponentDeadwoodPoints"`
+
+	// KnockIsRisky is true if the knock would likely lose, i.e. the opponent is estimated to have
+	// no more deadwood than the knocking player. Clients may ask for confirmation before knocking.
+	KnockIsRisky bool `json:"knockIsRisky"`
+}
+
+// PreviewKnock returns the preview of the player knocking right now.
+func (g GameState) PreviewKnock(playerID int) KnockPreview {
+	var (
+		deadwood         = g.BestDeadwood(playerID)
+		opponentDeadwood = g.estimateOpponentDeadwood(playerID)
+	)
+	return KnockPreview{
+		DeadwoodPoints:                  deadwood,
+		EstimatedOpponentDeadwoodPoints: opponentDeadwood,
+		// Ties go to the player who didn't knock.
+		KnockIsRisky: opponentDeadwood <= deadwood,
+	}
+}
+
+// estimateOpponentDeadwood estimates the deadwood points of the player's opponent, using only
+// information available to the player.
+//
+// The cards the opponent picked up from the discard pile (and still holds) are known. Every other
+// card in the opponent's hand is assumed to be worth the average value of the cards the player
+// hasn't seen this round.
+func (g GameState) estimateOpponentDeadwood(playerID int) int {
+	var (
+		opponentID    = g.OpponentOf(playerID)
+		opponentHand  = g.Players[opponentID].Hand.cards()
+		knownCards    = g.knownOpponentCards(playerID)
+		knownDeadwood = g.calculateDeadwoodPoints(knownCards, nil)
+		unknownCount  = len(opponentHand) - len(knownCards)
+	)
+
+	seen := map[Card]bool{}
+	for _, card := range g.seenCards(playerID) {
+		seen[card] = true
+	}
+	unseenCount, unseenPoints := 0, 0
+	for _, card := range spanishCards(g.RuleDeckSize) {
+		if !seen[card] {
+			unseenCount++
+			unseenPoints += g.cardValue(card)
+		}
+	}
+	if unseenCount == 0 || unknownCount <= 0 {
+		return knownDeadwood
+	}
+	return knownDeadwood + unknownCount*unseenPoints/unseenCount
+}
+
+// knownOpponentCards returns the cards the player knows to be in the opponent's hand: those the
+// opponent picked up from the discard pile this round and hasn't discarded or melded since.
+func (g GameState) knownOpponentCards(playerID int) []Card {
+	var (
+		opponentID  = g.OpponentOf(playerID)
+		discardPile = append([]Card{}, g.RoundsLog[g.RoundNumber].InitialDiscardPile...)
+		known       = []Card{}
+	)
+	remove := func(card Card) {
+		for i, c := range known {
+			if c == card {
+				known = append(known[:i], known[i+1:]...)
+				return
+			}
+		}
+	}
+
+	// Replay the discard pile through the round's actions to learn which cards were picked up.
+	for _, action := range _deserializeCurrentRoundActions(g) {
+		switch a := action.(type) {
+		case *ActionDiscardCard:
+			discardPile = append(discardPile, a.Card)
+			if a.PlayerID == opponentID {
+				remove(a.Card)
+			}
+		case *ActionDrawFromDiscardPile:
+			if len(discardPile) == 0 {
+				continue
+			}
+			card := discardPile[len(discardPile)-1]
+			discardPile = discardPile[:len(discardPile)-1]
+			if a.PlayerID == opponentID {
+				known = append(known, card)
+			}
+		case *ActionMeldCards:
+			if a.PlayerID == opponentID {
+				for _, card := range a.Cards {
+					remove(card)
+				}
+			}
+		case *ActionLayOffCard:
+			if a.PlayerID == opponentID {
+				remove(a.Card)
+			}
+		}
+	}
+	return known
+}
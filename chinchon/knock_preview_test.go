@@ -0,0 +1,69 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// logActions appends the actions to the current round's log, as if they had been run.
+func logActions(g *GameState, actions ...Action) {
+	roundLog := g.RoundsLog[g.RoundNumber]
+	for _, action := range actions {
+		roundLog.ActionsLog = append(roundLog.ActionsLog, ActionLog{PlayerID: action.GetPlayerID(), Action: SerializeAction(action)})
+	}
+}
+
+func TestKnockPreviewClearlyWinningKnockIsNotRisky(t *testing.T) {
+	gameState := New()
+	readyToKnock(gameState)
+
+	// Nothing is known about the opponent's 7 cards, which are worth many more than 7 points on
+	// average.
+	preview := gameState.PreviewKnock(gameState.TurnPlayerID)
+	require.Equal(t, 7, preview.DeadwoodPoints)
+	require.Greater(t, preview.EstimatedOpponentDeadwoodPoints, 30)
+	require.False(t, preview.KnockIsRisky)
+
+	cgs := gameState.ToClientGameState(gameState.TurnPlayerID)
+	require.Equal(t, &preview, cgs.KnockPreview)
+	require.Nil(t, gameState.ToClientGameState(gameState.TurnOpponentPlayerID).KnockPreview)
+}
+
+func TestKnockPreviewLikelyLosingKnockIsRisky(t *testing.T) {
+	gameState := New()
+	readyToKnock(gameState)
+	var (
+		you  = gameState.TurnPlayerID
+		them = gameState.TurnOpponentPlayerID
+	)
+	gameState.Players[you].Hand.Revealed[6] = Card{Suit: ORO, Number: 10}
+
+	// The opponent melded the 7s and picked up the 3 de oro, copa and espada from the discard
+	// pile, so their hand is known to be worth 9 points.
+	gameState.RoundsLog[gameState.RoundNumber].InitialDiscardPile = []Card{{Suit: ORO, Number: 3}}
+	logActions(gameState,
+		NewActionDrawFromDiscardPile(them),
+		NewActionDiscardCard(Card{Suit: BASTO, Number: 12}, them),
+		NewActionDrawFromDrawPile(you),
+		NewActionDiscardCard(Card{Suit: COPA, Number: 3}, you),
+		NewActionDrawFromDiscardPile(them),
+		NewActionDiscardCard(Card{Suit: BASTO, Number: 11}, them),
+		NewActionDrawFromDrawPile(you),
+		NewActionDiscardCard(Card{Suit: ESPADA, Number: 3}, you),
+		NewActionDrawFromDiscardPile(them),
+		NewActionMeldCards([]Card{{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 7}}, MeldTypeSet, them),
+		NewActionDiscardCard(Card{Suit: BASTO, Number: 10}, them),
+		NewActionDrawFromDrawPile(you),
+		NewActionDiscardCard(Card{Suit: BASTO, Number: 6}, you),
+	)
+	gameState.Players[them].Hand.Revealed = []Card{{Suit: ORO, Number: 3}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 3}}
+	gameState.Players[them].Melds = []*Meld{{Type: MeldTypeSet, Cards: []Card{{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 7}}}}
+
+	require.ElementsMatch(t, gameState.Players[them].Hand.Revealed, gameState.knownOpponentCards(you))
+	preview := gameState.PreviewKnock(you)
+	require.Equal(t, KnockPreview{DeadwoodPoints: 10, EstimatedOpponentDeadwoodPoints: 9, KnockIsRisky: true}, preview)
+
+	// The preview is advisory: the knock is still possible.
+	require.True(t, NewActionKnock(you).IsPossible(*gameState))
+}
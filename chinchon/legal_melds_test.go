@@ -0,0 +1,66 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegalMeldsMatchTheMeldActions(t *testing.T) {
+	gameState := New()
+	player := gameState.TurnPlayerID
+	readyToKnock(gameState)
+	gameState.Players[player].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 7},
+		{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6},
+	}
+
+	melds := gameState.LegalMelds(player)
+	// 4 sets of three 7s, and the 4-5-6, 5-6-7 and 4-5-6-7 de oro runs
+	require.Len(t, melds, 7)
+	for _, meld := range melds {
+		require.True(t, meld.IsValid(), "%v is not valid", meld)
+	}
+
+	meldActions := []*Meld{}
+	for _, action := range gameState.CalculatePossibleActions() {
+		if meldAction, ok := action.(*ActionMeldCards); ok {
+			meldActions = append(meldActions, &Meld{Type: meldAction.MeldType, Cards: meldAction.Cards})
+		}
+	}
+	require.ElementsMatch(t, melds, meldActions)
+}
+
+func TestLegalMeldsIsEmptyWithoutMelds(t *testing.T) {
+	gameState := New()
+	player := gameState.TurnPlayerID
+	gameState.Players[player].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 1}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 7},
+	}
+
+	require.Empty(t, gameState.LegalMelds(player))
+}
+
+func TestLegalMeldsOfAHand(t *testing.T) {
+	hand := []Card{
+		{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7},
+		{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: BASTO, Number: 1},
+	}
+
+	require.ElementsMatch(t, []*Meld{
+		{Type: MeldTypeSet, Cards: []Card{{Suit: ORO, Number: 7}, {Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}}},
+		{Type: MeldTypeRun, Cards: oros(4, 5, 6)},
+		{Type: MeldTypeRun, Cards: oros(5, 6, 7)},
+		{Type: MeldTypeRun, Cards: oros(4, 5, 6, 7)},
+	}, LegalMelds(hand))
+}
+
+func TestLegalMeldsOfAHandFollowDefaultRules(t *testing.T) {
+	hand := oros(11, 12, 1)
+
+	require.Empty(t, LegalMelds(hand))
+
+	gameState := New(WithAceWrap(AceWrapHighAllowed))
+	gameState.Players[0].Hand.Revealed = hand
+	require.Len(t, gameState.LegalMelds(0), 1)
+}
@@ -0,0 +1,30 @@
+package chinchon
+
+// DefaultMaxActionsPerRound is the number of actions after which a round is ended without a
+// knock. It's high enough that rounds where players try to win never get there.
+const DefaultMaxActionsPerRound = 1000
+
+// WithMaxActionsPerRound ends a round once it has run n actions without anyone knocking, e.g. when
+// neither player can make progress and the reshuffled draw pile keeps the round going forever. The
+// round is scored by comparing deadwood as if nobody knocked, so equal deadwood is a draw, and its
+// log is marked with IsMaxActionsReached. Zero disables it.
+func WithMaxActionsPerRound(n int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleMaxActionsPerRound = n
+	}
+}
+
+// countRoundAction counts an action run in the current round, and ends the round if it reached
+// RuleMaxActionsPerRound. The deck cut isn't counted, as it comes before the deal, so that a
+// redealt round counts the same (see redealRound).
+func (g *GameState) countRoundAction(action Action) {
+	if g.IsRoundFinished || g.IsGameEnded || action.GetName() == CUT_DECK {
+		return
+	}
+	g.RoundActionCount++
+	if g.RuleMaxActionsPerRound <= 0 || g.RoundActionCount < g.RuleMaxActionsPerRound {
+		return
+	}
+	g.RoundsLog[g.RoundNumber].IsMaxActionsReached = true
+	g.finishRound()
+}
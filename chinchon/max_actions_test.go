@@ -0,0 +1,70 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// drawAndDiscardTheDrawnCard plays turns where the turn player draws from the draw pile and
+// discards the card they drew, which never gets the round anywhere.
+func drawAndDiscardTheDrawnCard(t *testing.T, g *GameState, turns int) {
+	for i := 0; i < turns && !g.IsRoundFinished; i++ {
+		playerID := g.TurnPlayerID
+		require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(playerID)))
+		if g.IsRoundFinished {
+			return
+		}
+		hand := g.Players[playerID].Hand.Revealed
+		discardAndEndTurn(t, g, hand[len(hand)-1])
+	}
+}
+
+func TestMaxActionsPerRoundEndsALoopingRound(t *testing.T) {
+	g := New(WithSeed(1), WithMaxActionsPerRound(100))
+	require.Equal(t, 100, g.RuleMaxActionsPerRound)
+
+	drawAndDiscardTheDrawnCard(t, g, 1000)
+
+	require.True(t, g.IsRoundFinished)
+	require.False(t, g.IsGameEnded)
+	require.Equal(t, 100, g.RoundActionCount)
+	roundLog := g.RoundsLog[1]
+	require.True(t, roundLog.IsMaxActionsReached)
+	require.Positive(t, roundLog.DrawPileReshuffles)
+	require.Equal(t, -1, roundLog.KnockedPlayerID)
+	require.NotNil(t, roundLog.DeckOrder)
+
+	// The round is scored by the deadwood the players were left with
+	deadwoods := map[int]int{}
+	for _, playerID := range g.PlayerOrder {
+		require.Len(t, g.Players[playerID].Hand.Revealed, DefaultHandSize)
+		deadwoods[playerID] = g.BestDeadwood(playerID)
+	}
+	require.NotEqual(t, deadwoods[0], deadwoods[1])
+	winnerID, loserID := 0, 1
+	if deadwoods[1] < deadwoods[0] {
+		winnerID, loserID = 1, 0
+	}
+	require.Positive(t, deadwoods[winnerID])
+	require.Equal(t, winnerID, roundLog.WinnerPlayerID)
+	require.Equal(t, loserID, roundLog.LoserPlayerID)
+	require.Equal(t, deadwoods[loserID]-deadwoods[winnerID], roundLog.PointsAwarded)
+	require.Equal(t, roundLog.PointsAwarded, g.Players[winnerID].Score)
+
+	// The next round counts its actions from scratch
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(0)))
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(1)))
+	require.Equal(t, 2, g.RoundNumber)
+	require.Zero(t, g.RoundActionCount)
+}
+
+func TestMaxActionsPerRoundCanBeDisabled(t *testing.T) {
+	require.Equal(t, DefaultMaxActionsPerRound, New().RuleMaxActionsPerRound)
+
+	g := New(WithSeed(1), WithMaxActionsPerRound(0))
+	drawAndDiscardTheDrawnCard(t, g, 100)
+
+	require.False(t, g.IsRoundFinished)
+	require.False(t, g.RoundsLog[1].IsMaxActionsReached)
+}
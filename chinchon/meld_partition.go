@@ -0,0 +1,115 @@
+package chinchon
+
+import "sort"
+
+// candidateMelds returns every valid meld that can be formed with cards from the hand, including
+// overlapping ones: all sets of 3 or 4 cards and all runs of 3 or more cards.
+func (g GameState) candidateMelds(hand []Card) []*Meld {
+	melds := []*Meld{}
+
+	rankGroups := make(map[int][]Card)
+	suitGroups := make(map[string][]Card)
+	for _, card := range hand {
+		rankGroups[card.Number] = append(rankGroups[card.Number], card)
+		suitGroups[card.Suit] = append(suitGroups[card.Suit], card)
+	}
+
+	for _, number := range sortedKeys(rankGroups) {
+		cards := rankGroups[number]
+		for k := 3; k <= len(cards); k++ {
+			for _, combo := range g.generateCombinations(cards, k) {
+				if g.isValidSet(combo) {
+					melds = append(melds, &Meld{Type: MeldTypeSet, Cards: combo})
+				}
+			}
+		}
+	}
+
+	for _, suit := range []string{ORO, COPA, ESPADA, BASTO} {
+		cards := append([]Card{}, suitGroups[suit]...)
+		sortCardsByNumber(cards)
+		for _, run := range g.findConsecutiveRuns(cards) {
+			melds = append(melds, &Meld{Type: MeldTypeRun, Cards: append([]Card{}, run...)})
+		}
+	}
+
+	return melds
+}
+
+// bestMeldPartition searches for the arrangement of the hand into non-overlapping melds that
+// minimises deadwood points. It returns the chosen melds and the resulting deadwood.
+//
+// Hands are small (7 or 8 cards), so an exhaustive search over candidate melds is cheap.
+func (g GameState) bestMeldPartition(hand []Card) ([]*Meld, int) {
+	candidates := g.candidateMelds(hand)
+
+	var (
+		bestMelds    = []*Meld{}
+		bestDeadwood = g.calculateDeadwoodPoints(hand, nil)
+		used         = map[Card]bool{}
+		chosen       = []*Meld{}
+	)
+
+	var search func(from int)
+	search = func(from int) {
+		if deadwood := g.calculateDeadwoodPoints(hand, chosen); deadwood < bestDeadwood {
+			bestDeadwood = deadwood
+			bestMelds = append([]*Meld{}, chosen...)
+		}
+		for i := from; i < len(candidates); i++ {
+			if overlaps(candidates[i], used) {
+				continue
+			}
+			for _, card := range candidates[i].Cards {
+				used[card] = true
+			}
+			chosen = append(chosen, candidates[i])
+			search(i + 1)
+			chosen = chosen[:len(chosen)-1]
+			for _, card := range candidates[i].Cards {
+				delete(used, card)
+			}
+		}
+	}
+	search(0)
+
+	return bestMelds, bestDeadwood
+}
+
+func overlaps(meld *Meld, used map[Card]bool) bool {
+	for _, card := range meld.Cards {
+		if used[card] {
+			return true
+		}
+	}
+	return false
+}
+
+func sortCardsByNumber(cards []Card) {
+	sort.Slice(cards, func(i, j int) bool { return cards[i].Number < cards[j].Number })
+}
+
+func sortCardsBySuitAndNumber(cards []Card) {
+	sort.Slice(cards, func(i, j int) bool {
+		if cards[i].Suit != cards[j].Suit {
+			return cards[i].Suit < cards[j].Suit
+		}
+		return cards[i].Number < cards[j].Number
+	})
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := []int{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// OptimalMelds returns the arrangement of the hand into non-overlapping melds that minimises
+// deadwood points, along with the resulting deadwood. This only needs the cards, so it is
+// available to clients and bots that only see a ClientGameState.
+func OptimalMelds(hand []Card) ([]*Meld, int) {
+	return GameState{}.bestMeldPartition(hand)
+}
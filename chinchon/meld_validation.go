@@ -0,0 +1,127 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrCardNotInHand means a card to meld isn't in the player's hand.
+	ErrCardNotInHand = errors.New("card not in hand")
+
+	// ErrCardAlreadyMelded means a card to meld is already part of a meld on the table.
+	ErrCardAlreadyMelded = errors.New("card already melded")
+
+	// ErrTooFewMeldCards means a meld needs at least three cards.
+	ErrTooFewMeldCards = errors.New("too few cards to meld")
+
+	// ErrInvalidSet means the cards don't form a set: the same number in different suits.
+	ErrInvalidSet = errors.New("not a valid set")
+
+	// ErrInvalidRun means the cards don't form a run: consecutive numbers of the same suit.
+	ErrInvalidRun = errors.New("not a valid run")
+
+	// ErrWrongMeldType means the cards form a valid meld, but of the other type. Melding them is
+	// still possible, as ActionMeldCards corrects the type.
+	ErrWrongMeldType = errors.New("wrong meld type")
+
+	// ErrUnknownMeldType means the meld type is neither a set nor a run.
+	ErrUnknownMeldType = errors.New("unknown meld type")
+)
+
+// ValidateMeldCards checks whether the player can meld the cards as the given type, returning a
+// specific error for each way it can fail. Clients can call it as the user selects cards, to give
+// feedback before attempting the meld. It doesn't check whose turn it is.
+func (g GameState) ValidateMeldCards(playerID int, cards []Card, meldType MeldType) error {
+	hand := map[Card]bool{}
+	for _, card := range g.Players[playerID].Hand.cards() {
+		hand[card] = true
+	}
+	for _, card := range cards {
+		if g.isOnTable(card) {
+			return fmt.Errorf("%w: [%v]", ErrCardAlreadyMelded, card)
+		}
+		if !hand[card] {
+			return fmt.Errorf("%w: [%v]", ErrCardNotInHand, card)
+		}
+	}
+
+	if len(cards) < 3 {
+		return fmt.Errorf("%w: got %v", ErrTooFewMeldCards, len(cards))
+	}
+
+	meld := &ActionMeldCards{Cards: cards}
+	switch meldType {
+	case MeldTypeSet:
+		if meld.isValidSet() {
+			return nil
+		}
+		if meld.isValidRun(g.RuleAceWrap) {
+			return fmt.Errorf("%w: the cards form a run", ErrWrongMeldType)
+		}
+		return ErrInvalidSet
+	case MeldTypeRun:
+		if meld.isValidRun(g.RuleAceWrap) {
+			return nil
+		}
+		if meld.isValidSet() {
+			return fmt.Errorf("%w: the cards form a set", ErrWrongMeldType)
+		}
+		return ErrInvalidRun
+	default:
+		return fmt.Errorf("%w: [%v]", ErrUnknownMeldType, meldType)
+	}
+}
+
+// isOnTable returns true if the card is part of any player's melds.
+func (g GameState) isOnTable(card Card) bool {
+	for _, player := range g.Players {
+		if isMelded(card, player.Melds) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateArrangement checks a whole arrangement of a hand into melds at once, e.g. before a client
+// submits the melds and knocks: every meld must be valid, no card can be in two melds, and every
+// melded card must come from the hand. It returns a specific error for the first problem found.
+// Like Meld.IsValid, it follows the default rules, so runs never wrap around from the king to the ace.
+func ValidateArrangement(hand []Card, melds []*Meld) error {
+	inHand := map[Card]bool{}
+	for _, card := range hand {
+		inHand[card] = true
+	}
+	melded := map[Card]bool{}
+	for i, meld := range melds {
+		if err := invalidMeldError(meld); err != nil {
+			return fmt.Errorf("%w: meld %v %v", err, i, meld.Cards)
+		}
+		for _, card := range meld.Cards {
+			if !inHand[card] {
+				return fmt.Errorf("%w: [%v] in meld %v", ErrCardNotInHand, card, i)
+			}
+			if melded[card] {
+				return fmt.Errorf("%w: [%v] is melded twice", ErrCardAlreadyMelded, card)
+			}
+			melded[card] = true
+		}
+	}
+	return nil
+}
+
+// invalidMeldError returns why the meld isn't valid, or nil if it is.
+func invalidMeldError(meld *Meld) error {
+	switch {
+	case meld.IsValid():
+		return nil
+	case len(meld.Cards) < 3:
+		return ErrTooFewMeldCards
+	case meld.Type == MeldTypeSet:
+		return ErrInvalidSet
+	case meld.Type == MeldTypeRun:
+		return ErrInvalidRun
+	default:
+		return ErrUnknownMeldType
+	}
+}
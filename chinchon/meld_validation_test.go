@@ -0,0 +1,114 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMeldCards(t *testing.T) {
+	var (
+		oro4   = Card{Suit: ORO, Number: 4}
+		oro5   = Card{Suit: ORO, Number: 5}
+		oro6   = Card{Suit: ORO, Number: 6}
+		copa4  = Card{Suit: COPA, Number: 4}
+		basto4 = Card{Suit: BASTO, Number: 4}
+		copa12 = Card{Suit: COPA, Number: 12}
+		melded = Card{Suit: ESPADA, Number: 1}
+		absent = Card{Suit: ESPADA, Number: 7}
+	)
+	tests := []struct {
+		name     string
+		cards    []Card
+		meldType MeldType
+		expected error
+	}{
+		{name: "valid_set", cards: []Card{oro4, copa4, basto4}, meldType: MeldTypeSet, expected: nil},
+		{name: "valid_run", cards: []Card{oro6, oro4, oro5}, meldType: MeldTypeRun, expected: nil},
+		{name: "not_in_hand", cards: []Card{oro4, copa4, absent}, meldType: MeldTypeSet, expected: ErrCardNotInHand},
+		{name: "already_melded", cards: []Card{melded, oro4, oro5}, meldType: MeldTypeRun, expected: ErrCardAlreadyMelded},
+		{name: "too_few_cards", cards: []Card{oro4, copa4}, meldType: MeldTypeSet, expected: ErrTooFewMeldCards},
+		{name: "not_a_set", cards: []Card{oro4, copa4, copa12}, meldType: MeldTypeSet, expected: ErrInvalidSet},
+		{name: "not_a_run", cards: []Card{oro4, oro5, copa12}, meldType: MeldTypeRun, expected: ErrInvalidRun},
+		{name: "run_declared_as_set", cards: []Card{oro4, oro5, oro6}, meldType: MeldTypeSet, expected: ErrWrongMeldType},
+		{name: "set_declared_as_run", cards: []Card{oro4, copa4, basto4}, meldType: MeldTypeRun, expected: ErrWrongMeldType},
+		{name: "unknown_meld_type", cards: []Card{oro4, copa4, basto4}, meldType: "pair", expected: ErrUnknownMeldType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New()
+			player := gameState.TurnPlayerID
+			gameState.Players[player].Hand.Revealed = []Card{oro4, oro5, oro6, copa4, basto4, copa12}
+			gameState.Players[gameState.TurnOpponentPlayerID].Melds = []*Meld{
+				{Type: MeldTypeSet, Cards: []Card{melded, {Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}}},
+			}
+
+			err := gameState.ValidateMeldCards(player, tt.cards, tt.meldType)
+
+			if tt.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestMeldIsPossibleAgreesWithValidateMeldCards(t *testing.T) {
+	gameState := New()
+	player := gameState.TurnPlayerID
+	run := []Card{{Suit: ORO, Number: 4}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}}
+	gameState.Players[player].Hand.Revealed = append([]Card{{Suit: COPA, Number: 12}}, run...)
+
+	require.True(t, NewActionMeldCards(run, MeldTypeRun, player).IsPossible(*gameState))
+	require.True(t, NewActionMeldCards(run, MeldTypeSet, player).IsPossible(*gameState), "mislabeled melds are corrected")
+	require.False(t, NewActionMeldCards(run[:2], MeldTypeRun, player).IsPossible(*gameState))
+	require.False(t, NewActionMeldCards(run, "pair", player).IsPossible(*gameState))
+}
+
+func TestValidateArrangement(t *testing.T) {
+	var (
+		oro4    = Card{Suit: ORO, Number: 4}
+		oro5    = Card{Suit: ORO, Number: 5}
+		oro6    = Card{Suit: ORO, Number: 6}
+		copa4   = Card{Suit: COPA, Number: 4}
+		basto4  = Card{Suit: BASTO, Number: 4}
+		espada4 = Card{Suit: ESPADA, Number: 4}
+		copa12  = Card{Suit: COPA, Number: 12}
+		hand    = []Card{oro4, oro5, oro6, copa4, basto4, espada4, copa12}
+	)
+	tests := []struct {
+		name     string
+		melds    []*Meld
+		expected error
+	}{
+		{name: "no_melds", melds: []*Meld{}, expected: nil},
+		{name: "valid_set_and_run", melds: []*Meld{
+			{Type: MeldTypeSet, Cards: []Card{copa4, basto4, espada4}},
+			{Type: MeldTypeRun, Cards: []Card{oro4, oro5, oro6}},
+		}, expected: nil},
+		{name: "overlap", melds: []*Meld{
+			{Type: MeldTypeSet, Cards: []Card{oro4, copa4, basto4}},
+			{Type: MeldTypeRun, Cards: []Card{oro4, oro5, oro6}},
+		}, expected: ErrCardAlreadyMelded},
+		{name: "card_twice_in_a_meld", melds: []*Meld{{Type: MeldTypeSet, Cards: []Card{oro4, oro4, copa4}}}, expected: ErrCardAlreadyMelded},
+		{name: "invalid_set", melds: []*Meld{{Type: MeldTypeSet, Cards: []Card{oro4, copa4, copa12}}}, expected: ErrInvalidSet},
+		{name: "invalid_run", melds: []*Meld{{Type: MeldTypeRun, Cards: []Card{oro4, oro6, copa12}}}, expected: ErrInvalidRun},
+		{name: "too_few_cards", melds: []*Meld{{Type: MeldTypeRun, Cards: []Card{oro4, oro5}}}, expected: ErrTooFewMeldCards},
+		{name: "unknown_meld_type", melds: []*Meld{{Type: "pair", Cards: []Card{oro4, copa4, basto4}}}, expected: ErrUnknownMeldType},
+		{name: "phantom_card", melds: []*Meld{{Type: MeldTypeRun, Cards: []Card{oro4, oro5, oro6, {Suit: ORO, Number: 7}}}}, expected: ErrCardNotInHand},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArrangement(hand, tt.melds)
+
+			if tt.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
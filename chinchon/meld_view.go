@@ -0,0 +1,61 @@
+package chinchon
+
+import "slices"
+
+// MeldView is a meld laid out for rendering.
+type MeldView struct {
+	Type MeldType `json:"type"`
+
+	// Cards are the meld's cards in display order: runs in ascending order, and sets grouped by
+	// suit in deck order (oro, copa, espada, basto).
+	Cards []Card `json:"cards"`
+
+	// IsExtensible is true if a card that can still be played would extend the meld: a missing suit
+	// of a set, or the card at either end of a run. Cards in melds on the table or buried in the
+	// discard pile can't be played anymore.
+	IsExtensible bool `json:"isExtensible"`
+}
+
+// meldViews returns the player's melds laid out for rendering.
+func (g GameState) meldViews(playerID int) []MeldView {
+	var (
+		outOfPlay = map[Card]bool{}
+		inDeck    = map[Card]bool{}
+		views     = []MeldView{}
+	)
+	for _, player := range g.Players {
+		for _, meld := range player.Melds {
+			for _, card := range meld.Cards {
+				outOfPlay[card] = true
+			}
+		}
+	}
+	discarded := g.DiscardPile.cards()
+	for i := 0; i < len(discarded)-1; i++ {
+		outOfPlay[discarded[i]] = true
+	}
+	for _, card := range spanishCards(g.RuleDeckSize) {
+		inDeck[card] = true
+	}
+	playable := func(card Card) bool { return inDeck[card] && !outOfPlay[card] }
+
+	for _, meld := range g.Players[playerID].Melds {
+		view := MeldView{Type: meld.Type, Cards: slices.Clone(meld.Cards)}
+		switch meld.Type {
+		case MeldTypeRun:
+			sortCardsByNumber(view.Cards)
+			low, high := view.Cards[0], view.Cards[len(view.Cards)-1]
+			view.IsExtensible = playable(Card{Suit: low.Suit, Number: low.Number - 1}) ||
+				playable(Card{Suit: high.Suit, Number: high.Number + 1})
+		case MeldTypeSet:
+			slices.SortFunc(view.Cards, Card.Compare)
+			for _, suit := range []string{ORO, COPA, ESPADA, BASTO} {
+				if playable(Card{Suit: suit, Number: view.Cards[0].Number}) {
+					view.IsExtensible = true
+				}
+			}
+		}
+		views = append(views, view)
+	}
+	return views
+}
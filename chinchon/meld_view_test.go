@@ -0,0 +1,92 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeldViewsOrderCards(t *testing.T) {
+	gameState := New()
+	you := gameState.TurnPlayerID
+	gameState.Players[you].Melds = []*Meld{
+		{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 7}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}}},
+		{Type: MeldTypeSet, Cards: []Card{{Suit: BASTO, Number: 4}, {Suit: ORO, Number: 4}, {Suit: COPA, Number: 4}}},
+	}
+
+	views := gameState.ToClientGameState(you).YourMeldsDetailed
+
+	require.Len(t, views, 2)
+	require.Equal(t, MeldTypeRun, views[0].Type)
+	require.Equal(t, []Card{{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7}}, views[0].Cards)
+	require.Equal(t, MeldTypeSet, views[1].Type)
+	require.Equal(t, []Card{{Suit: ORO, Number: 4}, {Suit: COPA, Number: 4}, {Suit: BASTO, Number: 4}}, views[1].Cards)
+	// The melds themselves are left as they were laid.
+	require.Equal(t, Card{Suit: ORO, Number: 7}, gameState.Players[you].Melds[0].Cards[0])
+}
+
+func TestMeldViewsFlagExtensibility(t *testing.T) {
+	var (
+		run       = &Meld{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7}}}
+		set       = &Meld{Type: MeldTypeSet, Cards: []Card{{Suit: ORO, Number: 4}, {Suit: COPA, Number: 4}, {Suit: BASTO, Number: 4}}}
+		fullSet   = &Meld{Type: MeldTypeSet, Cards: []Card{{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}, {Suit: BASTO, Number: 1}}}
+		openRun   = &Meld{Type: MeldTypeRun, Cards: []Card{{Suit: COPA, Number: 2}, {Suit: COPA, Number: 3}, {Suit: COPA, Number: 4}}}
+		espada4   = Card{Suit: ESPADA, Number: 4}
+		otherCard = Card{Suit: BASTO, Number: 12}
+	)
+	tests := []struct {
+		name        string
+		opts        []func(*GameState)
+		melds       []*Meld
+		discardPile []Card
+		expected    []bool
+	}{
+		{
+			// The 4 de oro is in the set and there's no 8 in a 40-card deck, so the run is closed.
+			name:     "run_blocked_at_both_ends",
+			melds:    []*Meld{run, set},
+			expected: []bool{false, true},
+		},
+		{
+			name:     "run_extensible_with_8s_and_9s",
+			opts:     []func(*GameState){WithDeckSize(48)},
+			melds:    []*Meld{run, set},
+			expected: []bool{true, true},
+		},
+		{
+			name:        "set_blocked_by_buried_discard",
+			melds:       []*Meld{set},
+			discardPile: []Card{espada4, otherCard},
+			expected:    []bool{false},
+		},
+		{
+			name:        "set_extensible_from_top_discard",
+			melds:       []*Meld{set},
+			discardPile: []Card{otherCard, espada4},
+			expected:    []bool{true},
+		},
+		{
+			// The 1 de copa is in the full set, but the 5 de copa can still extend the run.
+			name:     "full_set_and_run_extensible_upwards",
+			melds:    []*Meld{fullSet, openRun},
+			expected: []bool{false, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+			you := gameState.TurnPlayerID
+			gameState.Players[you].Melds = tt.melds
+			gameState.DiscardPile = &Pile{Cards: tt.discardPile}
+
+			views := gameState.ToClientGameState(you).YourMeldsDetailed
+
+			extensible := []bool{}
+			for _, view := range views {
+				extensible = append(extensible, view.IsExtensible)
+			}
+			require.Equal(t, tt.expected, extensible)
+		})
+	}
+}
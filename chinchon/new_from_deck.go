@@ -0,0 +1,45 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errInvalidDeckOrder = errors.New("invalid deck order")
+
+// NewFromDeck is like New, but the first round is dealt from deckOrder exactly as given, without
+// shuffling, so that tests can set up precise opening scenarios. Cards are dealt from the front
+// as described in RoundLog.DeckOrder. Later rounds and rematches are shuffled as usual.
+//
+// deckOrder must contain every card of the deck (see WithDeckSize) exactly once.
+func NewFromDeck(deckOrder []Card, opts ...func(*GameState)) (*GameState, error) {
+	// Options only set rules, so applying them to a blank game reveals the deck size.
+	rules := &GameState{deck: newDeck(), RuleDeckSize: DefaultDeckSize}
+	for _, opt := range opts {
+		opt(rules)
+	}
+	if err := validateDeckOrder(deckOrder, rules.RuleDeckSize); err != nil {
+		return nil, err
+	}
+
+	order := append([]Card{}, deckOrder...)
+	return New(append(opts, func(gs *GameState) { gs.deck.order = order })...), nil
+}
+
+func validateDeckOrder(deckOrder []Card, deckSize int) error {
+	cards := spanishCards(deckSize)
+	if len(deckOrder) != len(cards) {
+		return fmt.Errorf("%w: got %v cards, expected %v", errInvalidDeckOrder, len(deckOrder), len(cards))
+	}
+	remaining := map[Card]bool{}
+	for _, card := range cards {
+		remaining[card] = true
+	}
+	for _, card := range deckOrder {
+		if !remaining[card] {
+			return fmt.Errorf("%w: [%v] is repeated or not in the deck", errInvalidDeckOrder, card)
+		}
+		delete(remaining, card)
+	}
+	return nil
+}
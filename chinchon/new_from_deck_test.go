@@ -0,0 +1,71 @@
+package chinchon
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromDeckDealsTheExactOrder(t *testing.T) {
+	order := spanishCards(DefaultDeckSize)
+	slices.Reverse(order)
+
+	g, err := NewFromDeck(order)
+	require.NoError(t, err)
+
+	// Cards are dealt alternately from the front, and the discard pile is seeded from the back.
+	for i := 0; i < DefaultHandSize; i++ {
+		require.Equal(t, order[2*i], g.Players[g.PlayerOrder[0]].Hand.Revealed[i])
+		require.Equal(t, order[2*i+1], g.Players[g.PlayerOrder[1]].Hand.Revealed[i])
+	}
+	dealt := 2 * DefaultHandSize
+	require.Equal(t, order[dealt:len(order)-1], g.DrawPile.Cards)
+	require.Equal(t, order[len(order)-1:], g.DiscardPile.Cards)
+}
+
+func TestNewFromDeckOnlyAppliesToTheFirstRound(t *testing.T) {
+	order := spanishCards(DefaultDeckSize)
+	g, err := NewFromDeck(order, WithSeed(1))
+	require.NoError(t, err)
+	firstHand := append([]Card{}, g.Players[0].Hand.Revealed...)
+
+	knockWinningRound(t, g)
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(0)))
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(1)))
+
+	require.Equal(t, 2, g.RoundNumber)
+	require.NotEqual(t, firstHand, g.Players[0].Hand.Revealed)
+}
+
+func TestNewFromDeckValidatesTheDeck(t *testing.T) {
+	full := spanishCards(DefaultDeckSize)
+	repeated := append([]Card{}, full...)
+	repeated[1] = repeated[0]
+
+	tests := []struct {
+		name  string
+		order []Card
+		opts  []func(*GameState)
+		valid bool
+	}{
+		{name: "full deck", order: full, valid: true},
+		{name: "48-card deck", order: spanishCards(48), opts: []func(*GameState){WithDeckSize(48)}, valid: true},
+		{name: "missing card", order: full[1:]},
+		{name: "repeated card", order: repeated},
+		{name: "40 cards for a 48-card deck", order: full, opts: []func(*GameState){WithDeckSize(48)}},
+		{name: "card not in the deck", order: append(full[1:], Card{Suit: ORO, Number: 8})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewFromDeck(tt.order, tt.opts...)
+			if !tt.valid {
+				require.ErrorIs(t, err, errInvalidDeckOrder)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.order, g.roundDeckOrder)
+		})
+	}
+}
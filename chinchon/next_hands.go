@@ -0,0 +1,100 @@
+package chinchon
+
+// HandOption is a hand the player can reach by drawing and then discarding, for one-ply search.
+type HandOption struct {
+	// DrawSource is the action that draws the card: DRAW_FROM_DRAW_PILE or DRAW_FROM_DISCARD_PILE.
+	DrawSource string `json:"drawSource"`
+
+	// Drawn is the card that would be drawn.
+	Drawn Card `json:"drawn"`
+
+	// Discard is the card that would be discarded afterwards. It may be the drawn card, unless it was
+	// taken from the discard pile.
+	Discard Card `json:"discard"`
+
+	// Hand is the resulting hand.
+	Hand []Card `json:"hand"`
+
+	// BestDeadwood is the lowest deadwood the resulting hand can reach (see BestDeadwood).
+	BestDeadwood int `json:"bestDeadwood"`
+
+	// Probability is the chance of drawing Drawn. It's 1 when the drawn card is known, i.e. for
+	// the discard pile, or for the draw pile in training mode. Otherwise, every card the player
+	// hasn't seen is equally likely to be drawn from the draw pile, so summing BestDeadwood times
+	// Probability over a discard strategy gives its expected deadwood.
+	Probability float64 `json:"probability"`
+}
+
+// NextHandStates enumerates the hands the player can reach by drawing from either pile and then
+// discarding any card, along with their best achievable deadwood.
+//
+// The top of the discard pile is known. The top of the draw pile is only known in training mode;
+// otherwise, options are listed for every card the player hasn't seen, weighted by Probability.
+func (g GameState) NextHandStates(playerID int) []HandOption {
+	var (
+		hand    = g.Players[playerID].Hand.cards()
+		options = []HandOption{}
+	)
+	addOptions := func(drawSource string, drawn Card, probability float64) {
+		drawnHand := append(append([]Card{}, hand...), drawn)
+		for _, discard := range drawnHand {
+			if drawSource == DRAW_FROM_DISCARD_PILE && discard == drawn && len(hand) > 0 {
+				continue
+			}
+			nextHand := without(drawnHand, discard)
+			_, deadwood := g.bestMeldPartition(nextHand)
+			options = append(options, HandOption{
+				DrawSource:   drawSource,
+				Drawn:        drawn,
+				Discard:      discard,
+				Hand:         nextHand,
+				BestDeadwood: deadwood,
+				Probability:  probability,
+			})
+		}
+	}
+
+	if card, err := g.DiscardPile.TopCard(); err == nil {
+		addOptions(DRAW_FROM_DISCARD_PILE, card, 1)
+	}
+
+	if g.DrawPile.IsEmpty() {
+		return options
+	}
+	if g.RuleIsTrainingMode {
+		card, _ := g.DrawPile.TopCard()
+		addOptions(DRAW_FROM_DRAW_PILE, card, 1)
+		return options
+	}
+	unseen := g.unseenCards(playerID)
+	for _, card := range unseen {
+		addOptions(DRAW_FROM_DRAW_PILE, card, 1/float64(len(unseen)))
+	}
+	return options
+}
+
+// unseenCards returns the cards the player hasn't seen this round (see seenCards), in deck order.
+func (g GameState) unseenCards(playerID int) []Card {
+	seen := map[Card]bool{}
+	for _, card := range g.seenCards(playerID) {
+		seen[card] = true
+	}
+	unseen := []Card{}
+	for _, card := range spanishCards(g.RuleDeckSize) {
+		if !seen[card] {
+			unseen = append(unseen, card)
+		}
+	}
+	return unseen
+}
+
+// without returns a copy of the cards without the given card.
+func without(cards []Card, card Card) []Card {
+	result := []Card{}
+	for _, c := range cards {
+		if c != card {
+			result = append(result, c)
+		}
+	}
+	return result
+}
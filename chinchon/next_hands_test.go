@@ -0,0 +1,85 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// optionsBySource groups hand options by their draw source.
+func optionsBySource(options []HandOption) map[string][]HandOption {
+	bySource := map[string][]HandOption{}
+	for _, option := range options {
+		bySource[option.DrawSource] = append(bySource[option.DrawSource], option)
+	}
+	return bySource
+}
+
+func TestNextHandStatesInTrainingMode(t *testing.T) {
+	gameState := New(WithTrainingMode(true))
+	player := gameState.TurnPlayerID
+	hand := gameState.Players[player].Hand.Revealed
+	discardTop, _ := gameState.DiscardPile.TopCard()
+	drawTop, _ := gameState.DrawPile.TopCard()
+
+	bySource := optionsBySource(gameState.NextHandStates(player))
+
+	for source, drawn := range map[string]Card{DRAW_FROM_DISCARD_PILE: discardTop, DRAW_FROM_DRAW_PILE: drawTop} {
+		// The top discard can't be discarded straight back.
+		canDiscardDrawn := source == DRAW_FROM_DRAW_PILE
+		discardCount := len(hand)
+		if canDiscardDrawn {
+			discardCount++
+		}
+		options := bySource[source]
+		require.Len(t, options, discardCount, source)
+
+		discards := map[Card]bool{}
+		for _, option := range options {
+			require.Equal(t, drawn, option.Drawn)
+			require.Equal(t, 1.0, option.Probability)
+			require.Len(t, option.Hand, len(hand))
+			require.NotContains(t, option.Hand, option.Discard)
+			_, deadwood := OptimalMelds(option.Hand)
+			require.Equal(t, deadwood, option.BestDeadwood)
+			discards[option.Discard] = true
+		}
+		// Every card in the hand, and the drawn card unless it's the top discard, can be discarded.
+		require.Len(t, discards, discardCount, source)
+		require.Equal(t, canDiscardDrawn, discards[drawn], source)
+	}
+}
+
+func TestNextHandStatesMarginalizeOverTheDrawPile(t *testing.T) {
+	gameState := New()
+	player := gameState.TurnPlayerID
+	hand := gameState.Players[player].Hand.Revealed
+	unseen := gameState.unseenCards(player)
+
+	bySource := optionsBySource(gameState.NextHandStates(player))
+
+	require.Len(t, bySource[DRAW_FROM_DISCARD_PILE], len(hand))
+	require.Len(t, bySource[DRAW_FROM_DRAW_PILE], len(unseen)*(len(hand)+1))
+
+	// For each discard strategy, probabilities add up to one.
+	total := 0.0
+	drawn := map[Card]bool{}
+	for _, option := range bySource[DRAW_FROM_DRAW_PILE] {
+		total += option.Probability
+		drawn[option.Drawn] = true
+	}
+	require.InDelta(t, float64(len(hand)+1), total, 1e-9)
+	require.Len(t, drawn, len(unseen))
+	for _, card := range hand {
+		require.False(t, drawn[card], "cards in hand can't be drawn")
+	}
+}
+
+func TestNextHandStatesWithoutDiscards(t *testing.T) {
+	gameState := New(WithInitialDiscardCount(0), WithTrainingMode(true))
+
+	bySource := optionsBySource(gameState.NextHandStates(gameState.TurnPlayerID))
+
+	require.Empty(t, bySource[DRAW_FROM_DISCARD_PILE])
+	require.Len(t, bySource[DRAW_FROM_DRAW_PILE], DefaultHandSize+1)
+}
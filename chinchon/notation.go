@@ -0,0 +1,200 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Move notation is a compact, human-readable encoding of a round's actions. Each action is written
+// as the acting player's ID followed by a code, and actions are separated by spaces:
+//
+//	D            draw from the draw pile
+//	P            draw from the discard pile
+//	X<card>      discard a card, e.g. X12e
+//	S<cards>     meld a set, e.g. S7o,7c,7e
+//	R<cards>     meld a run, e.g. R4b,5b,6b
+//	L<card>@<m>  lay off a card onto meld m, written as the meld's player ID and index, e.g. L7b@1.0
+//	K            knock
+//	E            end the turn
+//	EK           end the turn, declining to knock
+//	C            confirm that the round is finished
+//	N            decline the opening discard
+//	T<n>         cut the deck, moving n cards from the top to the bottom, e.g. T12
+//	F            forfeit the game
+//	A            agree to a rematch
+//
+// Cards are written as their number followed by the first letter of their suit. For example,
+// "0D 0X3c 1P 1X12e" means player 0 drew from the draw pile and discarded the 3 de copa, and then
+// player 1 took it and discarded the 12 de espada.
+
+var (
+	errInvalidNotation = errors.New("invalid move notation")
+	suitByLetter       = map[byte]string{'o': ORO, 'c': COPA, 'e': ESPADA, 'b': BASTO}
+)
+
+// EncodeActionsLog returns the move notation of the given actions log.
+func EncodeActionsLog(actionsLog []ActionLog) (string, error) {
+	tokens := make([]string, 0, len(actionsLog))
+	for _, actionLog := range actionsLog {
+		action, err := DeserializeAction(actionLog.Action)
+		if err != nil {
+			return "", err
+		}
+		token, err := actionNotation(action)
+		if err != nil {
+			return "", err
+		}
+		tokens = append(tokens, fmt.Sprintf("%d%s", actionLog.PlayerID, token))
+	}
+	return strings.Join(tokens, " "), nil
+}
+
+// DecodeActionsLog parses move notation back into an actions log.
+func DecodeActionsLog(notation string) ([]ActionLog, error) {
+	actionsLog := []ActionLog{}
+	for _, token := range strings.Fields(notation) {
+		i := 0
+		for i < len(token) && token[i] >= '0' && token[i] <= '9' {
+			i++
+		}
+		playerID, err := strconv.Atoi(token[:i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: missing player ID in [%v]", errInvalidNotation, token)
+		}
+		action, err := parseActionNotation(token[i:], playerID)
+		if err != nil {
+			return nil, err
+		}
+		actionsLog = append(actionsLog, ActionLog{PlayerID: playerID, Action: SerializeAction(action)})
+	}
+	return actionsLog, nil
+}
+
+func actionNotation(action Action) (string, error) {
+	switch a := action.(type) {
+	case *ActionDrawFromDrawPile:
+		return "D", nil
+	case *ActionDrawFromDiscardPile:
+		return "P", nil
+	case *ActionDiscardCard:
+		return "X" + cardNotation(a.Card), nil
+	case *ActionMeldCards:
+		code := "S"
+		if a.MeldType == MeldTypeRun {
+			code = "R"
+		}
+		cards := make([]string, 0, len(a.Cards))
+		for _, card := range a.Cards {
+			cards = append(cards, cardNotation(card))
+		}
+		return code + strings.Join(cards, ","), nil
+	case *ActionLayOffCard:
+		return fmt.Sprintf("L%s@%d.%d", cardNotation(a.Card), a.MeldPlayerID, a.MeldIndex), nil
+	case *ActionKnock:
+		return "K", nil
+	case *ActionEndTurn:
+		if a.DeclinedKnock {
+			return "EK", nil
+		}
+		return "E", nil
+	case *ActionConfirmRoundFinished:
+		return "C", nil
+	case *ActionDeclineOpeningDiscard:
+		return "N", nil
+	case *ActionCutDeck:
+		return fmt.Sprintf("T%d", a.Position), nil
+	case *ActionForfeit:
+		return "F", nil
+	case *ActionConfirmRematch:
+		return "A", nil
+	default:
+		return "", fmt.Errorf("%w: no notation for action [%v]", errInvalidNotation, action)
+	}
+}
+
+func parseActionNotation(token string, playerID int) (Action, error) {
+	if token == "" {
+		return nil, fmt.Errorf("%w: missing action code", errInvalidNotation)
+	}
+	code, rest := token[0], token[1:]
+	switch code {
+	case 'D':
+		return NewActionDrawFromDrawPile(playerID), nil
+	case 'P':
+		return NewActionDrawFromDiscardPile(playerID), nil
+	case 'X':
+		card, err := parseCardNotation(rest)
+		if err != nil {
+			return nil, err
+		}
+		return NewActionDiscardCard(card, playerID), nil
+	case 'S', 'R':
+		meldType := MeldTypeSet
+		if code == 'R' {
+			meldType = MeldTypeRun
+		}
+		cards := []Card{}
+		for _, s := range strings.Split(rest, ",") {
+			card, err := parseCardNotation(s)
+			if err != nil {
+				return nil, err
+			}
+			cards = append(cards, card)
+		}
+		return NewActionMeldCards(cards, meldType, playerID), nil
+	case 'L':
+		cardToken, target, ok := strings.Cut(rest, "@")
+		if !ok {
+			return nil, fmt.Errorf("%w: missing meld in [%v]", errInvalidNotation, token)
+		}
+		card, err := parseCardNotation(cardToken)
+		if err != nil {
+			return nil, err
+		}
+		meldPlayer, meldIndex, ok := strings.Cut(target, ".")
+		meldPlayerID, playerErr := strconv.Atoi(meldPlayer)
+		index, indexErr := strconv.Atoi(meldIndex)
+		if !ok || playerErr != nil || indexErr != nil {
+			return nil, fmt.Errorf("%w: invalid meld in [%v]", errInvalidNotation, token)
+		}
+		return NewActionLayOffCard(card, meldPlayerID, index, playerID), nil
+	case 'K':
+		return NewActionKnock(playerID), nil
+	case 'E':
+		return &ActionEndTurn{act: act{Name: END_TURN, PlayerID: playerID}, DeclinedKnock: rest == "K"}, nil
+	case 'C':
+		return NewActionConfirmRoundFinished(playerID), nil
+	case 'N':
+		return NewActionDeclineOpeningDiscard(playerID), nil
+	case 'T':
+		position, err := strconv.Atoi(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid cut in [%v]", errInvalidNotation, token)
+		}
+		return NewActionCutDeck(position, playerID), nil
+	case 'F':
+		return NewActionForfeit(playerID), nil
+	case 'A':
+		return NewActionConfirmRematch(playerID), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown action code in [%v]", errInvalidNotation, token)
+	}
+}
+
+func cardNotation(card Card) string {
+	return fmt.Sprintf("%d%c", card.Number, card.Suit[0])
+}
+
+func parseCardNotation(s string) (Card, error) {
+	if len(s) < 2 {
+		return Card{}, fmt.Errorf("%w: invalid card [%v]", errInvalidNotation, s)
+	}
+	suit, ok := suitByLetter[s[len(s)-1]]
+	number, err := strconv.Atoi(s[:len(s)-1])
+	if !ok || err != nil || number < 1 || number > 12 {
+		return Card{}, fmt.Errorf("%w: invalid card [%v]", errInvalidNotation, s)
+	}
+	return Card{Suit: suit, Number: number}, nil
+}
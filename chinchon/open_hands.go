@@ -0,0 +1,24 @@
+package chinchon
+
+// WithOpenHands makes both hands visible to both players, e.g. for teaching. Otherwise, players
+// only ever see their own hand.
+func WithOpenHands(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleIsOpenHands = enabled
+	}
+}
+
+// visibleHandCards returns the cards in the player's hand that their opponent may see: all of them
+// with open hands, and none otherwise.
+func (g GameState) visibleHandCards(playerID int) []Card {
+	if !g.RuleIsOpenHands {
+		return []Card{}
+	}
+	return append([]Card{}, g.Players[playerID].Hand.cards()...)
+}
+
+// visibleDeadwoodPoints returns the deadwood points of the player that their opponent may know: those
+// of their whole hand with open hands, or zero otherwise, as melded cards don't count.
+func (g GameState) visibleDeadwoodPoints(playerID int) int {
+	return g.calculateDeadwoodPoints(g.visibleHandCards(playerID), g.Players[playerID].Melds)
+}
@@ -0,0 +1,32 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheirHandCardsAreHiddenByDefault(t *testing.T) {
+	gameState := New()
+
+	for playerID := range gameState.Players {
+		cgs := gameState.ToClientGameState(playerID)
+		require.NotNil(t, cgs.TheirHandCards)
+		require.Empty(t, cgs.TheirHandCards)
+		require.Equal(t, gameState.Players[playerID].Hand.Revealed, cgs.YourHandCards)
+	}
+}
+
+func TestTheirHandCardsAreVisibleWithOpenHands(t *testing.T) {
+	gameState := New(WithOpenHands(true))
+
+	for playerID := range gameState.Players {
+		cgs := gameState.ToClientGameState(playerID)
+		require.Equal(t, gameState.Players[gameState.OpponentOf(playerID)].Hand.Revealed, cgs.TheirHandCards)
+	}
+
+	// Drawing shows up in the opponent's view straight away.
+	you := gameState.TurnPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(you)))
+	require.Len(t, gameState.ToClientGameState(gameState.OpponentOf(you)).TheirHandCards, DefaultHandSize+1)
+}
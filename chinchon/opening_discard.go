@@ -0,0 +1,46 @@
+package chinchon
+
+// OpeningDiscardRule controls whether the card seeding the discard pile may be taken on the first
+// turn of a round.
+type OpeningDiscardRule int
+
+const (
+	// OpeningDiscardAllow lets the player leading the round take the opening discard. It's the
+	// default.
+	OpeningDiscardAllow OpeningDiscardRule = iota
+
+	// OpeningDiscardForbid makes the player leading the round draw from the draw pile on the first
+	// turn.
+	OpeningDiscardForbid
+
+	// OpeningDiscardOfferToBoth offers the opening discard to the other players first, as in the
+	// classic gin rummy opening. It goes round the table starting with the player after the leader:
+	// whoever takes it plays the first turn, and play continues round the table from them. If every
+	// other player declines it, the leader plays the first turn as usual, and may still take it.
+	OpeningDiscardOfferToBoth
+)
+
+// WithOpeningDiscardRule sets whether the opening discard may be taken on the first turn of a round
+// (see OpeningDiscardRule).
+func WithOpeningDiscardRule(rule OpeningDiscardRule) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleOpeningDiscard = rule
+	}
+}
+
+// offerOpeningDiscard starts the round with the opening discard offered to the player after the
+// leader, under OpeningDiscardOfferToBoth. The offer is the only thing that happens before the
+// leader's first turn, so RoundTurnNumber stays at 1 throughout it.
+func (g *GameState) offerOpeningDiscard() {
+	if g.RuleOpeningDiscard != OpeningDiscardOfferToBoth || g.DiscardPile.IsEmpty() {
+		return
+	}
+	g.IsOpeningDiscardOffered = true
+	g.changeTurn()
+}
+
+// isOpeningDiscardForbidden returns true if the top of the discard pile can't be taken because it's
+// the opening discard, under OpeningDiscardForbid.
+func (g GameState) isOpeningDiscardForbidden() bool {
+	return g.RuleOpeningDiscard == OpeningDiscardForbid && g.RoundTurnNumber == 1
+}
@@ -0,0 +1,135 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// possibleActionNames returns the names of the actions the turn player can take, other than
+// forfeiting.
+func possibleActionNames(g *GameState) []string {
+	names := []string{}
+	for _, action := range g.CalculatePossibleActions() {
+		if action.GetPlayerID() == g.TurnPlayerID && !isForfeit(action) {
+			names = append(names, action.GetName())
+		}
+	}
+	return names
+}
+
+func TestOpeningDiscardRuleOnTheFirstTurn(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []func(*GameState)
+		isOffered       bool
+		expectedActions []string
+	}{
+		{name: "allowed_by_default", expectedActions: []string{DRAW_FROM_DRAW_PILE, DRAW_FROM_DISCARD_PILE}},
+		{name: "allowed", opts: []func(*GameState){WithOpeningDiscardRule(OpeningDiscardAllow)}, expectedActions: []string{DRAW_FROM_DRAW_PILE, DRAW_FROM_DISCARD_PILE}},
+		{name: "forbidden", opts: []func(*GameState){WithOpeningDiscardRule(OpeningDiscardForbid)}, expectedActions: []string{DRAW_FROM_DRAW_PILE}},
+		{name: "offered_to_both", opts: []func(*GameState){WithOpeningDiscardRule(OpeningDiscardOfferToBoth)}, isOffered: true, expectedActions: []string{DRAW_FROM_DISCARD_PILE, DECLINE_OPENING_DISCARD}},
+		{
+			name:            "not_offered_without_an_opening_discard",
+			opts:            []func(*GameState){WithOpeningDiscardRule(OpeningDiscardOfferToBoth), WithInitialDiscardCount(0)},
+			expectedActions: []string{DRAW_FROM_DRAW_PILE},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+
+			require.Equal(t, 1, gameState.RoundTurnNumber)
+			require.Equal(t, tt.isOffered, gameState.IsOpeningDiscardOffered)
+			require.Equal(t, tt.expectedActions, possibleActionNames(gameState))
+		})
+	}
+}
+
+func TestForbiddenOpeningDiscardCanBeTakenFromTheSecondTurn(t *testing.T) {
+	gameState := New(WithOpeningDiscardRule(OpeningDiscardForbid))
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(gameState.TurnPlayerID)))
+	discardAndEndTurn(t, gameState, gameState.Players[gameState.TurnPlayerID].Hand.Revealed[0])
+
+	require.Equal(t, 2, gameState.RoundTurnNumber)
+	require.Equal(t, []string{DRAW_FROM_DRAW_PILE, DRAW_FROM_DISCARD_PILE}, possibleActionNames(gameState))
+}
+
+func TestDeclinedOpeningDiscardIsOfferedToTheLeader(t *testing.T) {
+	gameState := New(WithOpeningDiscardRule(OpeningDiscardOfferToBoth))
+	offered, leader := gameState.TurnPlayerID, gameState.TurnOpponentPlayerID
+	isYourTurn, turnReason := gameState.turnReason(offered)
+	require.True(t, isYourTurn)
+	require.Equal(t, TurnReasonOpeningDiscardOffer, turnReason)
+	require.Error(t, gameState.RunAction(NewActionDrawFromDrawPile(offered)))
+
+	require.NoError(t, gameState.RunAction(NewActionDeclineOpeningDiscard(offered)))
+
+	require.False(t, gameState.IsOpeningDiscardOffered)
+	require.Equal(t, leader, gameState.TurnPlayerID)
+	require.Equal(t, 1, gameState.RoundTurnNumber)
+	require.Equal(t, []string{DRAW_FROM_DRAW_PILE, DRAW_FROM_DISCARD_PILE}, possibleActionNames(gameState))
+}
+
+func TestTakenOpeningDiscardMakesTheOfferedPlayerPlayTheFirstTurn(t *testing.T) {
+	gameState := New(WithOpeningDiscardRule(OpeningDiscardOfferToBoth))
+	offered, leader := gameState.TurnPlayerID, gameState.TurnOpponentPlayerID
+	openingDiscard, err := gameState.DiscardPile.TopCard()
+	require.NoError(t, err)
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDiscardPile(offered)))
+	require.False(t, gameState.IsOpeningDiscardOffered)
+	require.Contains(t, gameState.Players[offered].Hand.Revealed, openingDiscard)
+	discardAndEndTurn(t, gameState, gameState.Players[offered].Hand.Revealed[0])
+
+	require.Equal(t, leader, gameState.TurnPlayerID)
+	require.Equal(t, 2, gameState.RoundTurnNumber)
+	require.Equal(t, []string{DRAW_FROM_DRAW_PILE, DRAW_FROM_DISCARD_PILE}, possibleActionNames(gameState))
+}
+
+func TestDeclinedOpeningDiscardSurvivesNotation(t *testing.T) {
+	actionsLog := []ActionLog{{PlayerID: 1, Action: SerializeAction(NewActionDeclineOpeningDiscard(1))}}
+
+	notation, err := EncodeActionsLog(actionsLog)
+	require.NoError(t, err)
+	require.Equal(t, "1N", notation)
+
+	decoded, err := DecodeActionsLog(notation)
+	require.NoError(t, err)
+	require.Equal(t, actionsLog, decoded)
+}
+
+func TestOpeningDiscardIsOfferedRoundTheTable(t *testing.T) {
+	gameState := New(WithPlayers(3), WithOpeningDiscardRule(OpeningDiscardOfferToBoth))
+	seating := gameState.seatingFrom(gameState.RoundLeaderPlayerID)
+
+	turns := []int{}
+	for gameState.IsOpeningDiscardOffered {
+		turns = append(turns, gameState.TurnPlayerID)
+		require.NoError(t, gameState.RunAction(NewActionDeclineOpeningDiscard(gameState.TurnPlayerID)))
+	}
+	require.Equal(t, 1, gameState.RoundTurnNumber)
+	for len(turns) < 5 {
+		turns = append(turns, gameState.TurnPlayerID)
+		require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(gameState.TurnPlayerID)))
+		discardAndEndTurn(t, gameState, gameState.Players[gameState.TurnPlayerID].Hand.Revealed[0])
+	}
+
+	require.Equal(t, []int{seating[1], seating[2], seating[0], seating[1], seating[2]}, turns)
+	require.Equal(t, 4, gameState.RoundTurnNumber)
+}
+
+func TestOpeningDiscardTakenRoundTheTableMakesTheTakerPlayTheFirstTurn(t *testing.T) {
+	gameState := New(WithPlayers(3), WithOpeningDiscardRule(OpeningDiscardOfferToBoth))
+	seating := gameState.seatingFrom(gameState.RoundLeaderPlayerID)
+
+	require.NoError(t, gameState.RunAction(NewActionDeclineOpeningDiscard(seating[1])))
+	require.True(t, gameState.IsOpeningDiscardOffered)
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDiscardPile(seating[2])))
+	discardAndEndTurn(t, gameState, gameState.Players[seating[2]].Hand.Revealed[0])
+
+	require.Equal(t, seating[0], gameState.TurnPlayerID)
+	require.Equal(t, seating[1], gameState.TurnOpponentPlayerID)
+	require.Equal(t, 2, gameState.RoundTurnNumber)
+}
@@ -0,0 +1,108 @@
+package chinchon
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpponentOfFollowsPlayerOrder(t *testing.T) {
+	g := New()
+	g.Players[2] = &Player{}
+	g.PlayerOrder = []int{2, 0, 1}
+
+	// Map iteration order is random, so repeat to make sure it's not what's being followed.
+	for i := 0; i < 100; i++ {
+		require.Equal(t, 0, g.OpponentOf(2))
+		require.Equal(t, 1, g.OpponentOf(0))
+		require.Equal(t, 2, g.OpponentOf(1))
+	}
+}
+
+func TestRoundsAlternateStartingPlayerFollowingPlayerOrder(t *testing.T) {
+	g := New()
+	g.Players[2] = &Player{}
+	g.PlayerOrder = []int{0, 2, 1}
+
+	starters := []int{}
+	for i := 0; i < 6; i++ {
+		g.startNewRound()
+		starters = append(starters, g.TurnPlayerID)
+	}
+	require.Equal(t, []int{0, 2, 1, 0, 2, 1}, starters)
+}
+
+func TestCardsAreDealtInPlayerOrder(t *testing.T) {
+	for _, order := range [][]int{{0, 1}, {1, 0}} {
+		g := New(WithSeed(1))
+		g.PlayerOrder = order
+		g.startNewRound()
+
+		require.Equal(t, g.roundDeckOrder[0], g.Players[order[0]].Hand.Revealed[0])
+		require.Equal(t, g.roundDeckOrder[1], g.Players[order[1]].Hand.Revealed[0])
+	}
+}
+
+func TestRoundWinnerGoesFurthestPastTheWinningScore(t *testing.T) {
+	for _, order := range [][]int{{0, 1}, {1, 0}} {
+		g := New()
+		g.PlayerOrder = order
+		readyToKnock(g)
+		// The opponent is left with more deadwood than the knocker, so the knocker wins the round
+		g.Players[g.TurnOpponentPlayerID].Hand.Revealed = []Card{
+			{Suit: ORO, Number: 12}, {Suit: COPA, Number: 11}, {Suit: ESPADA, Number: 10}, {Suit: BASTO, Number: 12},
+			{Suit: ORO, Number: 7}, {Suit: COPA, Number: 6}, {Suit: ESPADA, Number: 5},
+		}
+		g.Players[0].Score = g.RuleMaxPoints
+		g.Players[1].Score = g.RuleMaxPoints
+		knockerID := g.TurnPlayerID
+
+		require.NoError(t, g.RunAction(NewActionKnock(knockerID)))
+
+		require.True(t, g.IsGameEnded)
+		require.Equal(t, knockerID, g.RoundsLog[g.RoundNumber].WinnerPlayerID)
+		require.Equal(t, knockerID, g.WinnerPlayerID)
+	}
+}
+
+func TestPlayersReachingTheWinningScoreAtOnce(t *testing.T) {
+	// Player 0 goes furthest past the winning score, unless it's a tie, which goes to the first
+	// player in seating order.
+	tests := []struct {
+		name   string
+		opts   []func(*GameState)
+		scores map[int]int
+		isTie  bool
+	}{
+		{name: "both_past_max_points", scores: map[int]int{0: 120, 1: 110}},
+		{name: "both_past_zero_subtractive", opts: []func(*GameState){WithSubtractiveScoring(true)}, scores: map[int]int{0: -20, 1: -10}},
+		{name: "equally_past_max_points", scores: map[int]int{0: 110, 1: 110}, isTie: true},
+		{name: "equally_past_zero_subtractive", opts: []func(*GameState){WithSubtractiveScoring(true)}, scores: map[int]int{0: -10, 1: -10}, isTie: true},
+	}
+
+	for _, tt := range tests {
+		for _, order := range [][]int{{0, 1}, {1, 0}} {
+			t.Run(fmt.Sprintf("%v_%v", tt.name, order), func(t *testing.T) {
+				for i := 0; i < 10; i++ {
+					g := New(tt.opts...)
+					g.PlayerOrder = order
+					for playerID, score := range tt.scores {
+						g.Players[playerID].Score = score
+					}
+
+					require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+
+					expectedWinnerID := 0
+					if tt.isTie {
+						expectedWinnerID = order[0]
+					}
+					require.True(t, g.IsGameEnded)
+					require.Equal(t, expectedWinnerID, g.WinnerPlayerID)
+					require.Equal(t, g.winningScore(), g.Players[0].Score)
+					require.Equal(t, g.winningScore(), g.Players[1].Score)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package chinchon
+
+// The number of players a game may have (see WithPlayers).
+const (
+	MinPlayers = 2
+	MaxPlayers = 4
+)
+
+// WithPlayers sets the number of players, with ids from 0 to n-1 seated in that order. Two players
+// is the default; a number outside MinPlayers and MaxPlayers is ignored.
+//
+// Turns go round the table in PlayerOrder, so TurnOpponentPlayerID is the player who plays next.
+// Every player must confirm a finished round before the next one is dealt. The player with the
+// lowest deadwood wins the round, and is awarded the difference with each other player's deadwood;
+// the loser is the player with the highest deadwood.
+func WithPlayers(n int) func(*GameState) {
+	return func(gs *GameState) {
+		if n < MinPlayers || n > MaxPlayers {
+			return
+		}
+		gs.Players = map[int]*Player{}
+		gs.PlayerOrder = []int{}
+		for playerID := 0; playerID < n; playerID++ {
+			gs.Players[playerID] = &Player{Hand: nil, Melds: nil, Score: 0}
+			gs.PlayerOrder = append(gs.PlayerOrder, playerID)
+		}
+	}
+}
+
+// seatingFrom returns the players in PlayerOrder, going round the table starting with playerID.
+func (g GameState) seatingFrom(playerID int) []int {
+	seating := []int{playerID}
+	for next := g.OpponentOf(playerID); next != playerID && next != -1; next = g.OpponentOf(next) {
+		seating = append(seating, next)
+	}
+	return seating
+}
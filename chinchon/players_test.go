@@ -0,0 +1,155 @@
+package chinchon
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPlayersDealsEveryPlayer(t *testing.T) {
+	for _, n := range []int{2, 3, 4} {
+		t.Run(fmt.Sprintf("%v_players", n), func(t *testing.T) {
+			g := New(WithPlayers(n), WithSeed(1))
+
+			require.Len(t, g.Players, n)
+			require.Len(t, g.PlayerOrder, n)
+			cards := append(append([]Card{}, g.DrawPile.Cards...), g.DiscardPile.Cards...)
+			for _, playerID := range g.PlayerOrder {
+				require.Len(t, g.Players[playerID].Hand.Revealed, DefaultHandSize)
+				require.Equal(t, g.Players[playerID].Hand.Revealed, g.RoundsLog[1].HandsDealt[playerID].Revealed)
+				cards = append(cards, g.Players[playerID].Hand.Revealed...)
+			}
+			require.ElementsMatch(t, spanishCards(DefaultDeckSize), cards)
+		})
+	}
+}
+
+func TestWithPlayersIgnoresInvalidNumbers(t *testing.T) {
+	for _, n := range []int{-1, 0, 1, 5} {
+		require.Equal(t, []int{0, 1}, New(WithPlayers(n)).PlayerOrder, n)
+	}
+}
+
+func TestTurnsGoRoundTheTable(t *testing.T) {
+	g := New(WithPlayers(3))
+	require.Equal(t, 1, g.TurnPlayerID)
+
+	turns := []int{}
+	for i := 0; i < 6; i++ {
+		turns = append(turns, g.TurnPlayerID)
+		require.Equal(t, g.OpponentOf(g.TurnPlayerID), g.TurnOpponentPlayerID)
+		playerID := g.TurnPlayerID
+		require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(playerID)))
+		discardAndEndTurn(t, g, g.Players[playerID].Hand.Revealed[0])
+	}
+	require.Equal(t, []int{1, 2, 0, 1, 2, 0}, turns)
+}
+
+func TestEveryPlayerConfirmsTheRoundFinished(t *testing.T) {
+	g := New(WithPlayers(4), WithMaxPoints(1000))
+	knockWinningRound(t, g)
+
+	for i := 0; i < 3; i++ {
+		confirms := []int{}
+		for _, action := range g.CalculatePossibleActions() {
+			confirms = append(confirms, action.GetPlayerID())
+		}
+		require.Len(t, confirms, 4-i)
+		require.Equal(t, g.TurnPlayerID, confirms[0])
+
+		require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+		require.Equal(t, 1, g.RoundNumber)
+	}
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+	require.Equal(t, 2, g.RoundNumber)
+}
+
+func TestRoundScoreWithMorePlayers(t *testing.T) {
+	var (
+		fiveDeadwood = []Card{
+			{Suit: ORO, Number: 3}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 3},
+			{Suit: ORO, Number: 4}, {Suit: COPA, Number: 4}, {Suit: ESPADA, Number: 4},
+			{Suit: BASTO, Number: 5},
+		}
+		sevenDeadwood = []Card{
+			{Suit: ORO, Number: 5}, {Suit: COPA, Number: 5}, {Suit: ESPADA, Number: 5},
+			{Suit: ORO, Number: 6}, {Suit: COPA, Number: 6}, {Suit: ESPADA, Number: 6},
+			{Suit: BASTO, Number: 7},
+		}
+		highDeadwood = []Card{
+			{Suit: ORO, Number: 10}, {Suit: COPA, Number: 11}, {Suit: ESPADA, Number: 12}, {Suit: BASTO, Number: 10},
+			{Suit: ORO, Number: 11}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 7},
+		}
+	)
+
+	tests := []struct {
+		name           string
+		hands          [][]Card // Of the players after the knocker, in seating order
+		expectedWinner int      // Seats after the knocker
+		expectedLoser  int      // Seats after the knocker
+	}{
+		{name: "knocker_wins", hands: [][]Card{highDeadwood, highDeadwood}, expectedWinner: 0, expectedLoser: 1},
+		{name: "knocker_is_undercut", hands: [][]Card{highDeadwood, fiveDeadwood}, expectedWinner: 2, expectedLoser: 1},
+		{name: "tie_goes_to_the_next_tied_player", hands: [][]Card{highDeadwood, sevenDeadwood}, expectedWinner: 2, expectedLoser: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New(WithPlayers(3), WithMaxPoints(1000))
+			knockerID := g.TurnPlayerID
+			readyToKnock(g)
+			seating := g.seatingFrom(knockerID)
+			for i, hand := range tt.hands {
+				g.Players[seating[i+1]].Hand.Revealed = hand
+			}
+			deadwoods := map[int]int{}
+			for _, playerID := range seating {
+				deadwoods[playerID] = g.BestDeadwood(playerID)
+			}
+			require.Equal(t, 7, deadwoods[knockerID])
+
+			require.NoError(t, g.RunAction(NewActionKnock(knockerID)))
+
+			roundLog := g.RoundsLog[1]
+			winnerID, loserID := seating[tt.expectedWinner], seating[tt.expectedLoser]
+			expectedPoints := 0
+			for _, playerID := range seating {
+				expectedPoints += deadwoods[playerID] - deadwoods[winnerID]
+			}
+			if winnerID != knockerID {
+				expectedPoints += g.RuleUndercutBonus
+			}
+			require.Equal(t, winnerID, roundLog.WinnerPlayerID)
+			require.Equal(t, loserID, roundLog.LoserPlayerID)
+			require.Equal(t, deadwoods[loserID], roundLog.LoserDeadwoodPoints)
+			require.Equal(t, expectedPoints, roundLog.PointsAwarded)
+			require.Equal(t, expectedPoints, g.Players[winnerID].Score)
+		})
+	}
+}
+
+func TestClientGameStateListsEveryOpponent(t *testing.T) {
+	g := New(WithPlayers(4))
+
+	cgs := g.ToClientGameState(2)
+
+	opponents := []int{}
+	for _, opponent := range cgs.Opponents {
+		opponents = append(opponents, opponent.PlayerID)
+		require.Equal(t, len(g.Players[opponent.PlayerID].Hand.Revealed), opponent.HandCount)
+		require.Empty(t, opponent.HandCards)
+	}
+	require.Equal(t, []int{3, 0, 1}, opponents)
+	require.Equal(t, 3, cgs.ThemPlayerID)
+}
+
+func TestRandomGamesWithMorePlayers(t *testing.T) {
+	for _, n := range []int{3, 4} {
+		for seed := int64(0); seed < 5; seed++ {
+			g := playSeededGame(t, seed, WithPlayers(n))
+			require.True(t, g.IsGameEnded, "%v players, seed %v", n, seed)
+			require.Equal(t, g.winningScore(), g.Players[g.WinnerPlayerID].Score)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package chinchon
+
+// PointsToWin returns how many more points the player needs to win the game, or zero if the game
+// has ended.
+//
+// Points only ever move towards the winning score (RuleMaxPoints, or zero with subtractive
+// scoring), so this is the distance from the player's score to it.
+func (g GameState) PointsToWin(playerID int) int {
+	if g.IsGameEnded {
+		return 0
+	}
+	if g.RuleIsSubtractiveScoring {
+		return max(0, g.Players[playerID].Score)
+	}
+	return max(0, g.RuleMaxPoints-g.Players[playerID].Score)
+}
@@ -0,0 +1,42 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPointsToWin(t *testing.T) {
+	tests := []struct {
+		name     string
+		score    int
+		isEnded  bool
+		expected int
+	}{
+		{name: "new_game", score: 0, expected: DefaultMaxPoints},
+		{name: "near_threshold", score: DefaultMaxPoints - 1, expected: 1},
+		{name: "at_threshold", score: DefaultMaxPoints, expected: 0},
+		{name: "past_threshold_is_clamped", score: DefaultMaxPoints + 5, expected: 0},
+		{name: "ended_game", score: 40, isEnded: true, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New()
+			gameState.Players[0].Score = tt.score
+			gameState.IsGameEnded = tt.isEnded
+
+			require.Equal(t, tt.expected, gameState.PointsToWin(0))
+		})
+	}
+}
+
+func TestPointsToWinInClientGameState(t *testing.T) {
+	gameState := New(WithMaxPoints(50))
+	gameState.Players[0].Score = 45
+	gameState.Players[1].Score = 10
+
+	cgs := gameState.ToClientGameState(0)
+	require.Equal(t, 5, cgs.YourPointsToWin)
+	require.Equal(t, 40, cgs.TheirPointsToWin)
+}
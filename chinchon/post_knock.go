@@ -0,0 +1,7 @@
+package chinchon
+
+// isPostKnockPhase returns true if a knock ended the round, and the game waits for the players to
+// confirm its result before the next round.
+func (g GameState) isPostKnockPhase() bool {
+	return g.IsRoundFinished && !g.IsGameEnded && g.KnockedPlayerID != -1
+}
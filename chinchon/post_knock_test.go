@@ -0,0 +1,43 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostKnockPhase(t *testing.T) {
+	gameState := New()
+	knocker := gameState.TurnPlayerID
+	for _, playerID := range gameState.PlayerOrder {
+		cgs := gameState.ToClientGameState(playerID)
+		require.False(t, cgs.IsPostKnockPhase)
+		require.Equal(t, -1, cgs.KnockerPlayerID)
+	}
+
+	knockWinningRound(t, gameState)
+	for _, playerID := range gameState.PlayerOrder {
+		cgs := gameState.ToClientGameState(playerID)
+		require.True(t, cgs.IsPostKnockPhase)
+		require.Equal(t, knocker, cgs.KnockerPlayerID)
+	}
+
+	// The phase lasts until both players confirm the round.
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(knocker)))
+	require.True(t, gameState.ToClientGameState(knocker).IsPostKnockPhase)
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(gameState.OpponentOf(knocker))))
+
+	cgs := gameState.ToClientGameState(knocker)
+	require.False(t, cgs.IsPostKnockPhase)
+	require.Equal(t, -1, cgs.KnockerPlayerID)
+}
+
+func TestNoPostKnockPhaseOnceTheGameEnds(t *testing.T) {
+	gameState := New(WithMaxPoints(50))
+	knockWinningRound(t, gameState)
+	require.True(t, gameState.IsGameEnded)
+
+	cgs := gameState.ToClientGameState(0)
+	require.False(t, cgs.IsPostKnockPhase)
+	require.Equal(t, -1, cgs.KnockerPlayerID)
+}
@@ -0,0 +1,101 @@
+package chinchon
+
+// PotentialMeld is a meld the player is one card short of completing, e.g. holding the 5 and 6 de
+// oro, the 4 or the 7 de oro would complete a run.
+type PotentialMeld struct {
+	// Type is the type of meld that would be completed.
+	Type MeldType `json:"type"`
+
+	// Cards are the cards in the player's hand that are part of the potential meld.
+	Cards []Card `json:"cards"`
+
+	// CompletingCards are the cards that would complete the meld. Only cards that can still be
+	// drawn are included (see GinCards for which cards are known not to be).
+	CompletingCards []Card `json:"completingCards"`
+}
+
+// MeldPotential returns the melds the player is one card short of completing: pairs of the same
+// number (for sets) and pairs of the same suit at most one number apart (for runs), along with the
+// cards that would complete each.
+//
+// Only cards left over by the best arrangement of the hand into melds are considered, since cards
+// already in a meld don't guide discards. Potential melds that can no longer be completed are left
+// out.
+func (g GameState) MeldPotential(playerID int) []PotentialMeld {
+	var (
+		hand        = g.unmeldedCards(g.Players[playerID].Hand.cards())
+		unavailable = g.unavailableCards(playerID)
+		inDeck      = map[Card]bool{}
+		rankGroups  = map[int][]Card{}
+		suitGroups  = map[string][]Card{}
+		potential   = []PotentialMeld{}
+	)
+	for _, card := range spanishCards(g.RuleDeckSize) {
+		inDeck[card] = true
+	}
+	for _, card := range hand {
+		rankGroups[card.Number] = append(rankGroups[card.Number], card)
+		suitGroups[card.Suit] = append(suitGroups[card.Suit], card)
+	}
+
+	available := func(cards ...Card) []Card {
+		result := []Card{}
+		for _, card := range cards {
+			if inDeck[card] && !unavailable[card] {
+				result = append(result, card)
+			}
+		}
+		return result
+	}
+	add := func(meldType MeldType, cards []Card, completing []Card) {
+		if len(completing) > 0 {
+			potential = append(potential, PotentialMeld{Type: meldType, Cards: cards, CompletingCards: completing})
+		}
+	}
+
+	for _, number := range sortedKeys(rankGroups) {
+		if cards := rankGroups[number]; len(cards) == 2 {
+			candidates := []Card{}
+			for _, suit := range []string{ORO, COPA, ESPADA, BASTO} {
+				candidates = append(candidates, Card{Suit: suit, Number: number})
+			}
+			add(MeldTypeSet, append([]Card{}, cards...), available(candidates...))
+		}
+	}
+
+	for _, suit := range []string{ORO, COPA, ESPADA, BASTO} {
+		cards := append([]Card{}, suitGroups[suit]...)
+		sortCardsByNumber(cards)
+		for i := 0; i < len(cards); i++ {
+			for j := i + 1; j < len(cards); j++ {
+				low, high := cards[i], cards[j]
+				switch high.Number - low.Number {
+				case 1:
+					add(MeldTypeRun, []Card{low, high}, available(Card{Suit: suit, Number: low.Number - 1}, Card{Suit: suit, Number: high.Number + 1}))
+				case 2:
+					add(MeldTypeRun, []Card{low, high}, available(Card{Suit: suit, Number: low.Number + 1}))
+				}
+			}
+		}
+	}
+
+	return potential
+}
+
+// unmeldedCards returns the cards of the hand left over by its best arrangement into melds.
+func (g GameState) unmeldedCards(hand []Card) []Card {
+	melds, _ := g.bestMeldPartition(hand)
+	melded := map[Card]bool{}
+	for _, meld := range melds {
+		for _, card := range meld.Cards {
+			melded[card] = true
+		}
+	}
+	unmelded := []Card{}
+	for _, card := range hand {
+		if !melded[card] {
+			unmelded = append(unmelded, card)
+		}
+	}
+	return unmelded
+}
@@ -0,0 +1,63 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeldPotentialOneAwayRun(t *testing.T) {
+	gameState := New()
+	gameState.Players[0].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6},
+		{Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 3}, {Suit: BASTO, Number: 11},
+		{Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 12},
+	}
+	gameState.DiscardPile.Cards = []Card{{Suit: BASTO, Number: 2}}
+
+	require.Equal(t, []PotentialMeld{
+		{
+			Type:            MeldTypeRun,
+			Cards:           []Card{{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}},
+			CompletingCards: []Card{{Suit: ORO, Number: 4}, {Suit: ORO, Number: 7}},
+		},
+	}, gameState.MeldPotential(0))
+}
+
+func TestMeldPotentialOneAwaySet(t *testing.T) {
+	gameState := New()
+	gameState.Players[0].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 3}, {Suit: ESPADA, Number: 3},
+		{Suit: COPA, Number: 1}, {Suit: BASTO, Number: 6}, {Suit: BASTO, Number: 11},
+		{Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 12},
+	}
+	// The 3 de copa is buried in the discard pile, so only the 3 de basto completes the set.
+	gameState.DiscardPile.Cards = []Card{{Suit: COPA, Number: 3}, {Suit: BASTO, Number: 2}}
+
+	require.Equal(t, []PotentialMeld{
+		{
+			Type:            MeldTypeSet,
+			Cards:           []Card{{Suit: ORO, Number: 3}, {Suit: ESPADA, Number: 3}},
+			CompletingCards: []Card{{Suit: BASTO, Number: 3}},
+		},
+	}, gameState.MeldPotential(0))
+}
+
+func TestMeldPotentialGapAndMeldedCards(t *testing.T) {
+	gameState := New()
+	gameState.Players[0].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+		{Suit: COPA, Number: 10}, {Suit: COPA, Number: 12},
+		{Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 7},
+	}
+	gameState.DiscardPile.Cards = []Card{{Suit: BASTO, Number: 2}}
+
+	// The run of oro is already complete, so it's not a potential meld.
+	require.Equal(t, []PotentialMeld{
+		{
+			Type:            MeldTypeRun,
+			Cards:           []Card{{Suit: COPA, Number: 10}, {Suit: COPA, Number: 12}},
+			CompletingCards: []Card{{Suit: COPA, Number: 11}},
+		},
+	}, gameState.MeldPotential(0))
+}
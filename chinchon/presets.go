@@ -0,0 +1,42 @@
+package chinchon
+
+// PresetClassic returns the options for classic Chinchón: 7-card hands dealt from the 40-card
+// Spanish deck, knocking with up to 10 deadwood points, playing to 100 points.
+//
+// These are also the defaults, so New(PresetClassic()...) is equivalent to New().
+func PresetClassic() []func(*GameState) {
+	return []func(*GameState){
+		WithHandSize(DefaultHandSize),
+		WithKnockThreshold(DefaultKnockThreshold),
+		WithGinBonus(DefaultGinBonus),
+		WithUndercutBonus(DefaultUndercutBonus),
+		WithMaxPoints(DefaultMaxPoints),
+		WithDeckSize(DefaultDeckSize),
+	}
+}
+
+// PresetGinStyle returns the options for a Gin Rummy flavoured game: 10-card hands dealt from the
+// 48-card Spanish deck (including 8s and 9s), with a bigger undercut bonus.
+func PresetGinStyle() []func(*GameState) {
+	return []func(*GameState){
+		WithHandSize(10),
+		WithKnockThreshold(10),
+		WithGinBonus(25),
+		WithUndercutBonus(25),
+		WithMaxPoints(100),
+		WithDeckSize(48),
+	}
+}
+
+// PresetFast returns the options for a short game: knocking is allowed with up to 15 deadwood
+// points, and the game is played to 50 points.
+func PresetFast() []func(*GameState) {
+	return []func(*GameState){
+		WithHandSize(DefaultHandSize),
+		WithKnockThreshold(15),
+		WithGinBonus(DefaultGinBonus),
+		WithUndercutBonus(DefaultUndercutBonus),
+		WithMaxPoints(50),
+		WithDeckSize(DefaultDeckSize),
+	}
+}
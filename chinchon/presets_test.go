@@ -0,0 +1,70 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresets(t *testing.T) {
+	tests := []struct {
+		name                   string
+		opts                   []func(*GameState)
+		expectedHandSize       int
+		expectedKnockThreshold int
+		expectedGinBonus       int
+		expectedUndercutBonus  int
+		expectedMaxPoints      int
+		expectedDeckSize       int
+	}{
+		{
+			name:                   "classic",
+			opts:                   PresetClassic(),
+			expectedHandSize:       7,
+			expectedKnockThreshold: 10,
+			expectedGinBonus:       25,
+			expectedUndercutBonus:  10,
+			expectedMaxPoints:      100,
+			expectedDeckSize:       40,
+		},
+		{
+			name:                   "gin_style",
+			opts:                   PresetGinStyle(),
+			expectedHandSize:       10,
+			expectedKnockThreshold: 10,
+			expectedGinBonus:       25,
+			expectedUndercutBonus:  25,
+			expectedMaxPoints:      100,
+			expectedDeckSize:       48,
+		},
+		{
+			name:                   "fast",
+			opts:                   PresetFast(),
+			expectedHandSize:       7,
+			expectedKnockThreshold: 15,
+			expectedGinBonus:       25,
+			expectedUndercutBonus:  10,
+			expectedMaxPoints:      50,
+			expectedDeckSize:       40,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+
+			require.Equal(t, tt.expectedHandSize, gameState.RuleHandSize)
+			require.Equal(t, tt.expectedKnockThreshold, gameState.RuleKnockThreshold)
+			require.Equal(t, tt.expectedGinBonus, gameState.RuleGinBonus)
+			require.Equal(t, tt.expectedUndercutBonus, gameState.RuleUndercutBonus)
+			require.Equal(t, tt.expectedMaxPoints, gameState.RuleMaxPoints)
+			require.Equal(t, tt.expectedDeckSize, gameState.RuleDeckSize)
+
+			// The deal must honour the configured hand and deck sizes.
+			require.Len(t, gameState.Players[0].Hand.Revealed, tt.expectedHandSize)
+			require.Len(t, gameState.Players[1].Hand.Revealed, tt.expectedHandSize)
+			totalCards := 2*tt.expectedHandSize + len(gameState.DrawPile.Cards) + len(gameState.DiscardPile.Cards)
+			require.Equal(t, tt.expectedDeckSize, totalCards)
+		})
+	}
+}
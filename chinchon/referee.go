@@ -0,0 +1,42 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVetoedByReferee means the game's referee rejected the action (see GameState.SetReferee).
+var ErrVetoedByReferee = errors.New("vetoed by referee")
+
+// Referee reviews actions for officiated play, e.g. tournaments with special rules.
+type Referee interface {
+	// Review is called for every action that passes the built-in checks, before it runs. It
+	// returns whether the action is allowed, and optionally a reason, which is recorded in the
+	// round's log whether or not the action is allowed.
+	Review(g GameState, a Action) (allow bool, reason string)
+}
+
+// SetReferee sets a referee that reviews every action before it runs. A nil referee removes it.
+func (g *GameState) SetReferee(referee Referee) {
+	g.referee = referee
+}
+
+// review asks the referee, if any, to review the action. It returns the referee's reason, and an
+// error if the action was vetoed, in which case the veto is recorded in the round's log.
+func (g *GameState) review(action Action) (string, error) {
+	if g.referee == nil {
+		return "", nil
+	}
+	allow, reason := g.referee.Review(*g, action)
+	if allow {
+		return reason, nil
+	}
+
+	roundLog := g.RoundsLog[g.RoundNumber]
+	roundLog.VetoedActionsLog = append(roundLog.VetoedActionsLog, ActionLog{
+		PlayerID:    action.GetPlayerID(),
+		Action:      SerializeAction(action),
+		RefereeNote: reason,
+	})
+	return "", fmt.Errorf("%w trying to run [%v]: %v", ErrVetoedByReferee, action, reason)
+}
@@ -0,0 +1,72 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// noDrawingFromDiscardPile is a referee for a house rule that forbids taking the top discard.
+type noDrawingFromDiscardPile struct{}
+
+func (noDrawingFromDiscardPile) Review(g GameState, a Action) (bool, string) {
+	switch a.GetName() {
+	case DRAW_FROM_DISCARD_PILE:
+		return false, "house rule: the discard pile is closed"
+	case DRAW_FROM_DRAW_PILE:
+		return true, "drawn under house rules"
+	}
+	return true, ""
+}
+
+func TestRefereeVetoBlocksAction(t *testing.T) {
+	gameState := New()
+	gameState.SetReferee(noDrawingFromDiscardPile{})
+	playerID := gameState.TurnPlayerID
+	discardPileBefore := append([]Card{}, gameState.DiscardPile.Cards...)
+
+	err := gameState.RunAction(NewActionDrawFromDiscardPile(playerID))
+	require.ErrorIs(t, err, ErrVetoedByReferee)
+	require.ErrorContains(t, err, "house rule: the discard pile is closed")
+
+	require.False(t, gameState.HasDrawnThisTurn)
+	require.Equal(t, discardPileBefore, gameState.DiscardPile.Cards)
+
+	roundLog := gameState.RoundsLog[gameState.RoundNumber]
+	require.Empty(t, roundLog.ActionsLog)
+	require.Equal(t, []ActionLog{{
+		PlayerID:    playerID,
+		Action:      SerializeAction(NewActionDrawFromDiscardPile(playerID)),
+		RefereeNote: "house rule: the discard pile is closed",
+	}}, roundLog.VetoedActionsLog)
+}
+
+func TestRefereeAnnotatesAllowedActions(t *testing.T) {
+	gameState := New()
+	gameState.SetReferee(noDrawingFromDiscardPile{})
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(gameState.TurnPlayerID)))
+
+	roundLog := gameState.RoundsLog[gameState.RoundNumber]
+	require.Len(t, roundLog.ActionsLog, 1)
+	require.Equal(t, "drawn under house rules", roundLog.ActionsLog[0].RefereeNote)
+	require.Empty(t, roundLog.VetoedActionsLog)
+}
+
+func TestRefereeOnlyReviewsPossibleActions(t *testing.T) {
+	gameState := New()
+	gameState.SetReferee(noDrawingFromDiscardPile{})
+
+	// Knocking before drawing fails the built-in checks, so the referee never sees it.
+	err := gameState.RunAction(NewActionKnock(gameState.TurnPlayerID))
+	require.ErrorIs(t, err, ErrActionNotPossible)
+	require.Empty(t, gameState.RoundsLog[gameState.RoundNumber].VetoedActionsLog)
+}
+
+func TestRematchKeepsReferee(t *testing.T) {
+	gameState := New()
+	gameState.SetReferee(noDrawingFromDiscardPile{})
+
+	rematch := gameState.Rematch()
+	require.ErrorIs(t, rematch.RunAction(NewActionDrawFromDiscardPile(rematch.TurnPlayerID)), ErrVetoedByReferee)
+}
@@ -0,0 +1,20 @@
+package chinchon
+
+// WithTrainingMode adds learning aids to ClientGameState, such as RemainingCardEstimate. They
+// only use information available to each player.
+func WithTrainingMode(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleIsTrainingMode = enabled
+	}
+}
+
+// remainingCardEstimate returns, per suit, how many cards the player hasn't seen this round, i.e.
+// cards that may be in the draw pile or in the opponent's hand. It's derived from seenCards, so
+// it's an estimate from the player's point of view rather than exact knowledge.
+func (g GameState) remainingCardEstimate(playerID int) map[string]int {
+	remaining := map[string]int{ORO: 0, COPA: 0, ESPADA: 0, BASTO: 0}
+	for _, card := range g.unseenCards(playerID) {
+		remaining[card.Suit]++
+	}
+	return remaining
+}
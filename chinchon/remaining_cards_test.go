@@ -0,0 +1,33 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemainingCardEstimateIsOnlySetInTrainingMode(t *testing.T) {
+	require.Nil(t, New().ToClientGameState(0).RemainingCardEstimate)
+	require.NotNil(t, New(WithTrainingMode(true)).ToClientGameState(0).RemainingCardEstimate)
+}
+
+func TestSeenCardsReduceRemainingCardEstimate(t *testing.T) {
+	gameState := New(WithTrainingMode(true))
+	roundLog := gameState.RoundsLog[gameState.RoundNumber]
+	hand := []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+		{Suit: COPA, Number: 5}, {Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 5},
+		{Suit: ORO, Number: 12},
+	}
+	gameState.Players[0].Hand.Revealed = hand
+	roundLog.HandsDealt[0] = &Hand{Revealed: hand}
+	roundLog.InitialDiscardPile = []Card{{Suit: COPA, Number: 10}}
+	gameState.DiscardPile.Cards = []Card{{Suit: COPA, Number: 10}}
+
+	// 10 cards per suit; seen are 4 oros, 2 copas, 1 espada and 1 basto.
+	require.Equal(t, map[string]int{ORO: 6, COPA: 8, ESPADA: 9, BASTO: 9}, gameState.ToClientGameState(0).RemainingCardEstimate)
+
+	// An opponent's meld reveals more cards.
+	gameState.Players[1].Melds = []*Meld{{Type: MeldTypeSet, Cards: []Card{{Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 7}, {Suit: BASTO, Number: 7}}}}
+	require.Equal(t, map[string]int{ORO: 6, COPA: 7, ESPADA: 8, BASTO: 8}, gameState.ToClientGameState(0).RemainingCardEstimate)
+}
@@ -0,0 +1,55 @@
+package chinchon
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Rematch returns a fresh game between the same players, with the same rules as this one and
+// officiated by the same referee, if any. Scores start again from zero, or from the starting scores
+// if any (see WithStartingScores). The rematch is built from the game's Rule* fields, so it works
+// on deserialized games too, and it's dealt afresh: neither the seed nor a fixed deck order are
+// carried over (see WithSeed and NewFromDeck).
+func (g *GameState) Rematch() *GameState {
+	rematch := New(withRulesOf(g))
+	rematch.referee = g.referee
+	return rematch
+}
+
+// withRulesOf sets up a game with the players and rules of another one. Every Rule* field is copied,
+// so that rules added later carry over to rematches without changes here. Maps are cloned, so the
+// rematch doesn't share them with the original game.
+func withRulesOf(g *GameState) func(*GameState) {
+	return func(gs *GameState) {
+		gs.Players = map[int]*Player{}
+		for _, playerID := range g.PlayerOrder {
+			gs.Players[playerID] = &Player{Hand: nil, Melds: nil, Score: 0}
+		}
+		gs.PlayerOrder = append([]int{}, g.PlayerOrder...)
+
+		from, to := reflect.ValueOf(g).Elem(), reflect.ValueOf(gs).Elem()
+		for i := 0; i < from.NumField(); i++ {
+			if !strings.HasPrefix(from.Type().Field(i).Name, "Rule") {
+				continue
+			}
+			rule := from.Field(i)
+			if rule.Kind() == reflect.Map && !rule.IsNil() {
+				clone := reflect.MakeMapWithSize(rule.Type(), rule.Len())
+				for it := rule.MapRange(); it.Next(); {
+					clone.SetMapIndex(it.Key(), it.Value())
+				}
+				rule = clone
+			}
+			to.Field(i).Set(rule)
+		}
+		gs.isActionPooled = g.isActionPooled
+	}
+}
+
+// startRematch starts the game over as its rematch, once every player confirmed it (see
+// ActionConfirmRematch). The game keeps its observers, as it's still the same game to them.
+func (g *GameState) startRematch() {
+	observers := g.observers
+	*g = *g.Rematch()
+	g.observers = observers
+}
@@ -0,0 +1,122 @@
+package chinchon
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRematchKeepsRulesAndResetsScores(t *testing.T) {
+	gameState := New(WithMaxPoints(50), WithKnockThreshold(5))
+	gameState.Players[0].Score = 50
+	gameState.IsGameEnded = true
+	gameState.WinnerPlayerID = 0
+
+	rematch := gameState.Rematch()
+
+	require.False(t, rematch.IsGameEnded)
+	require.Equal(t, -1, rematch.WinnerPlayerID)
+	require.Equal(t, 1, rematch.RoundNumber)
+	require.Equal(t, 0, rematch.Players[0].Score)
+	require.Equal(t, 0, rematch.Players[1].Score)
+	require.Equal(t, 50, rematch.RuleMaxPoints)
+	require.Equal(t, 5, rematch.RuleKnockThreshold)
+}
+
+func TestRematchKeepsEveryRule(t *testing.T) {
+	gameState := New(
+		WithPlayers(3), WithMaxPoints(50), WithHandSize(6), WithKnockThreshold(5), WithGinBonus(20),
+		WithUndercutBonus(5), WithDeckSize(48), WithInitialDiscardCount(2), WithNoFirstTurnKnock(true),
+		WithStartingScores(map[int]int{1: 10}), WithAutoDiscardSingleOption(true), WithStallDetection(4),
+		WithMaxActionsPerRound(500), WithCompactFinishedRounds(true), WithDeclinedKnockPenalty(5),
+		WithRoundPointsCap(30), WithRoundPointsRounding(5), WithBestOf(3), WithAceWrap(AceWrapLowOnly),
+		WithSubtractiveScoring(true), WithStrictDiscardDraw(true), WithOpeningDiscardRule(OpeningDiscardForbid),
+		WithCut(CutRandom), WithChinchonBonus(40), WithChinchonEndsGame(true), WithActionLogging(ActionLoggingNone),
+		WithOpenHands(true), WithTrainingMode(true), WithAnalysisMode(true), WithDebugValidation(true),
+		WithCardValues(map[int]int{12: 5}),
+	)
+
+	rematch := gameState.Rematch()
+
+	expected, actual, defaults := reflect.ValueOf(*gameState), reflect.ValueOf(*rematch), reflect.ValueOf(*New())
+	for i := 0; i < expected.NumField(); i++ {
+		if name := expected.Type().Field(i).Name; strings.HasPrefix(name, "Rule") {
+			require.NotEqual(t, defaults.Field(i).Interface(), expected.Field(i).Interface(), "%v must be set above, so it's checked", name)
+			require.Equal(t, expected.Field(i).Interface(), actual.Field(i).Interface(), name)
+		}
+	}
+	require.Equal(t, gameState.PlayerOrder, rematch.PlayerOrder)
+
+	rematch.RuleCardValues[12] = 7
+	require.Equal(t, 5, gameState.RuleCardValues[12], "the rematch doesn't share maps with the original game")
+}
+
+func TestRematchOfADeserializedGame(t *testing.T) {
+	gameState := New(WithPlayers(3), WithMaxPoints(50))
+	bs, err := gameState.Serialize()
+	require.NoError(t, err)
+	deserialized, err := Deserialize(bs)
+	require.NoError(t, err)
+
+	rematch := deserialized.Rematch()
+
+	require.Len(t, rematch.PlayerOrder, 3)
+	require.Equal(t, 50, rematch.RuleMaxPoints)
+	require.Equal(t, 1, rematch.RoundNumber)
+}
+
+func TestRematchIsDealtAfresh(t *testing.T) {
+	deckOrder := append([]Card{}, New(WithSeed(1)).roundDeckOrder...)
+	fixedDeck, err := NewFromDeck(deckOrder)
+	require.NoError(t, err)
+
+	for name, gameState := range map[string]*GameState{"seeded": New(WithSeed(1)), "fixed_deck": fixedDeck} {
+		t.Run(name, func(t *testing.T) {
+			rematch := gameState.Rematch()
+
+			require.Nil(t, rematch.Seed)
+			require.NotEqual(t, gameState.roundDeckOrder, rematch.roundDeckOrder)
+		})
+	}
+}
+
+func TestConfirmedRematchStartsTheGameOver(t *testing.T) {
+	gameState := New(WithPlayers(3), WithMaxPoints(50), WithKnockThreshold(5))
+	gameState.Players[2].Score = 30
+	events := []Event{}
+	gameState.AddObserver(func(event Event) { events = append(events, event) })
+	require.NoError(t, gameState.RunAction(NewActionForfeit(0)))
+
+	for _, playerID := range []int{2, 0} {
+		require.NoError(t, gameState.RunAction(NewActionConfirmRematch(playerID)))
+		require.True(t, gameState.IsGameEnded)
+		require.ErrorIs(t, gameState.RunAction(NewActionConfirmRematch(playerID)), ErrActionNotPossible)
+	}
+	require.Len(t, gameState.CalculatePossibleActions(), 1)
+	require.Equal(t, NewActionConfirmRematch(1), gameState.CalculatePossibleActions()[0])
+
+	require.NoError(t, gameState.RunAction(NewActionConfirmRematch(1)))
+
+	require.False(t, gameState.IsGameEnded)
+	require.Equal(t, -1, gameState.WinnerPlayerID)
+	require.Equal(t, 1, gameState.RoundNumber)
+	require.Empty(t, gameState.RematchConfirmedPlayerIDs)
+	for _, playerID := range []int{0, 1, 2} {
+		require.Equal(t, 0, gameState.Players[playerID].Score)
+	}
+	require.Len(t, gameState.PlayerOrder, 3)
+	require.Equal(t, 50, gameState.RuleMaxPoints)
+	require.Equal(t, 5, gameState.RuleKnockThreshold)
+	require.Equal(t, Event{Type: EventRoundStarted, PlayerID: gameState.TurnPlayerID, RoundNumber: 1}, events[len(events)-1])
+}
+
+func TestRematchCantBeConfirmedBeforeTheGameEnds(t *testing.T) {
+	gameState := New()
+
+	require.Error(t, gameState.RunAction(NewActionConfirmRematch(gameState.TurnPlayerID)))
+	for _, action := range gameState.CalculatePossibleActions() {
+		require.NotEqual(t, CONFIRM_REMATCH, action.GetName())
+	}
+}
@@ -0,0 +1,170 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+var (
+	errInvalidReplayIndex = errors.New("invalid replay index")
+	errInvalidRoundsLog   = errors.New("invalid rounds log")
+	errReplayMismatch     = errors.New("replay doesn't match the log")
+)
+
+// WithSeed makes the deck shuffle deterministically from the given seed, so that a game can be
+// reproduced from its seed and its actions (see Replay), e.g. to attach to a bug report.
+//
+// Each round is re-seeded from the seed and the round number, so a round's deal only depends on
+// which round it is, not on how earlier rounds went.
+func WithSeed(seed int64) func(*GameState) {
+	return func(gs *GameState) {
+		gs.Seed = &seed
+	}
+}
+
+// reseedRound seeds the deck's source for the given round, if the game is seeded.
+func (g *GameState) reseedRound(roundNumber int) {
+	g.deck.rng = g.seededRand(roundNumber, 0)
+}
+
+// seededRand returns a source derived from the seed for the given round and reshuffle of the draw
+// pile within it (zero for the deal), or nil if the game isn't seeded.
+func (g GameState) seededRand(roundNumber, reshuffle int) *rand.Rand {
+	if g.Seed == nil {
+		return nil
+	}
+	return rand.New(rand.NewSource((*g.Seed*1_000_003+int64(roundNumber))*1_009 + int64(reshuffle)))
+}
+
+// Replay rebuilds a game created with WithSeed(seed) and the given options by running all of its
+// serialized actions in order, including round finished confirmations.
+func Replay(seed int64, actions [][]byte, opts ...func(*GameState)) (*GameState, error) {
+	return ReplayTo(seed, actions, len(actions), opts...)
+}
+
+// ReplayTo is like Replay, but only runs the first index actions, so that the game can be
+// inspected at any point, e.g. to scrub through it in a review UI. An index of zero returns the
+// game as dealt; the index may stop the replay at any point within a round.
+func ReplayTo(seed int64, actions [][]byte, index int, opts ...func(*GameState)) (*GameState, error) {
+	if index < 0 || index > len(actions) {
+		return nil, fmt.Errorf("%w: [%v] is not between 0 and %v", errInvalidReplayIndex, index, len(actions))
+	}
+
+	g := New(append([]func(*GameState){WithSeed(seed)}, opts...)...)
+	for i, bs := range actions[:index] {
+		action, err := DeserializeAction(bs)
+		if err != nil {
+			return nil, fmt.Errorf("replaying action %v: %w", i, err)
+		}
+		if err := g.RunAction(action); err != nil {
+			return nil, fmt.Errorf("replaying action %v: %w", i, err)
+		}
+	}
+	return g, nil
+}
+
+// ReplayGame rebuilds a game from its rounds log, indexed by round number like GameState.RoundsLog,
+// and the options it was created with. Each round is dealt as logged and its actions are run in
+// order; as round finished confirmations aren't logged, every player confirms every round but the
+// last. Unlike Replay, it doesn't need the seed, so any stored game can be replayed, e.g. in a
+// replay viewer, or to verify that the game played out deterministically.
+//
+// Every round must be finished, or end the game with a forfeit, and every action must be logged
+// (see WithActionLogging). If the draw pile was reshuffled, the options must include the game's
+// seed, or the reshuffle can't be reproduced. It fails if an action can't be run, or a round doesn't end as logged.
+func ReplayGame(logs []*RoundLog, opts ...func(*GameState)) (*GameState, error) {
+	if len(logs) < 2 {
+		return nil, fmt.Errorf("%w: no rounds were played", errInvalidRoundsLog)
+	}
+
+	g := New(opts...)
+	for roundNumber := 1; roundNumber < len(logs); roundNumber++ {
+		if roundNumber > 1 {
+			if g.IsGameEnded {
+				return nil, fmt.Errorf("%w: the game ended in round %v, but %v rounds were logged", errReplayMismatch, g.RoundNumber, len(logs)-1)
+			}
+			for _, playerID := range g.seatingFrom(g.TurnPlayerID) {
+				if err := g.RunAction(NewActionConfirmRoundFinished(playerID)); err != nil {
+					return nil, fmt.Errorf("%w: confirming round %v finished: %v", errReplayMismatch, g.RoundNumber, err)
+				}
+			}
+		}
+		if err := g.replayLoggedRound(logs[roundNumber]); err != nil {
+			return nil, fmt.Errorf("replaying round %v: %w", roundNumber, err)
+		}
+	}
+	return g, nil
+}
+
+// replayLoggedRound deals the current round as in the given log, and runs the log's actions.
+func (g *GameState) replayLoggedRound(log *RoundLog) error {
+	if log == nil {
+		return fmt.Errorf("%w: the round wasn't logged", errInvalidRoundsLog)
+	}
+	actions, err := log.Actions()
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidRoundsLog, err)
+	}
+	first := 0
+	if len(actions) > 0 && actionName(actions[0]) == CUT_DECK {
+		first = 1
+	}
+	switch {
+	case log.DeckOrder == nil || len(actions) <= first:
+		return fmt.Errorf("%w: the round isn't finished", errInvalidRoundsLog)
+	case g.Seed == nil && log.DrawPileReshuffles > 0:
+		return fmt.Errorf("%w: the draw pile was reshuffled in an unseeded game", errInvalidRoundsLog)
+	}
+
+	// Deal the round as logged rather than as shuffled. The deck cut is already part of the
+	// logged deck order, so it's kept in the log without being run.
+	roundLog := g.RoundsLog[g.RoundNumber]
+	roundLog.HandsDealt = map[int]*Hand{}
+	for _, playerID := range g.PlayerOrder {
+		hand, ok := log.HandsDealt[playerID]
+		if !ok || hand == nil {
+			return fmt.Errorf("%w: player %v's dealt hand wasn't logged", errInvalidRoundsLog, playerID)
+		}
+		dealt := hand.DeepCopy()
+		roundLog.HandsDealt[playerID] = &dealt
+	}
+	roundLog.InitialDiscardPile = cloneCards(log.InitialDiscardPile)
+	roundLog.ActionsLog = append([]ActionLog{}, actions[:first]...)
+	g.roundDeckOrder = cloneCards(log.DeckOrder)
+	g.redealRound(actions[first].PlayerID)
+
+	for i, actionLog := range actions[first:] {
+		if len(roundLog.ActionsLog) > first+i {
+			continue // Already run automatically
+		}
+		action, err := DeserializeAction(actionLog.Action)
+		if err != nil {
+			return fmt.Errorf("%w: action %v: %v", errInvalidRoundsLog, first+i, err)
+		}
+		if err := g.RunAction(action); err != nil {
+			return fmt.Errorf("%w: action %v: %v", errReplayMismatch, first+i, err)
+		}
+	}
+
+	switch {
+	case len(roundLog.ActionsLog) != len(actions):
+		return fmt.Errorf("%w: %v actions were run, but %v were logged", errReplayMismatch, len(roundLog.ActionsLog), len(actions))
+	case !g.IsRoundFinished && !g.IsGameEnded:
+		return fmt.Errorf("%w: the round didn't finish", errReplayMismatch)
+	case roundLog.WinnerPlayerID != log.WinnerPlayerID || roundLog.IsDraw != log.IsDraw:
+		return fmt.Errorf("%w: player %v won the round, but player %v was logged", errReplayMismatch, roundLog.WinnerPlayerID, log.WinnerPlayerID)
+	case roundLog.PointsAwarded != log.PointsAwarded:
+		return fmt.Errorf("%w: %v points were awarded, but %v were logged", errReplayMismatch, roundLog.PointsAwarded, log.PointsAwarded)
+	}
+	for i := range actions {
+		if string(roundLog.ActionsLog[i].Action) != string(actions[i].Action) {
+			return fmt.Errorf("%w: action %v was %s, but %s was logged", errReplayMismatch, i, roundLog.ActionsLog[i].Action, actions[i].Action)
+		}
+	}
+
+	// Keep the logged actions as they were, e.g. with their referee notes
+	roundLog.ActionsLog = append([]ActionLog{}, actions...)
+	roundLog.VetoedActionsLog = append([]ActionLog(nil), log.VetoedActionsLog...)
+	return nil
+}
@@ -0,0 +1,206 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeededGamesAreDealtIdentically(t *testing.T) {
+	g1 := New(WithSeed(42))
+	g2 := New(WithSeed(42))
+
+	require.Equal(t, g1.Players[0].Hand.Revealed, g2.Players[0].Hand.Revealed)
+	require.Equal(t, g1.Players[1].Hand.Revealed, g2.Players[1].Hand.Revealed)
+	require.Equal(t, g1.DrawPile.Cards, g2.DrawPile.Cards)
+	require.Equal(t, g1.DiscardPile.Cards, g2.DiscardPile.Cards)
+}
+
+func TestSeededRoundsDontDependOnEarlierRounds(t *testing.T) {
+	quick, long := New(WithSeed(42)), New(WithSeed(42))
+	require.Equal(t, int64(42), *quick.Seed)
+	require.Nil(t, New().Seed)
+
+	// One game's first round ends straight away, and the other's after its draw pile is reshuffled.
+	knockWinningRound(t, quick)
+	drawPile := long.DrawPile.Cards
+	long.DrawPile.Cards = drawPile[len(drawPile)-1:]
+	long.DiscardPile.Cards = append(append([]Card{}, drawPile[:len(drawPile)-1]...), long.DiscardPile.Cards...)
+	playerID := long.TurnPlayerID
+	require.NoError(t, long.RunAction(NewActionDrawFromDrawPile(playerID)))
+	discardAndEndTurn(t, long, long.Players[playerID].Hand.Revealed[0])
+	knockWinningRound(t, long)
+
+	for _, g := range []*GameState{quick, long} {
+		require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+		require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+		require.Equal(t, 2, g.RoundNumber)
+	}
+	require.Equal(t, quick.Players[0].Hand.Revealed, long.Players[0].Hand.Revealed)
+	require.Equal(t, quick.Players[1].Hand.Revealed, long.Players[1].Hand.Revealed)
+	require.Equal(t, quick.DrawPile.Cards, long.DrawPile.Cards)
+}
+
+func TestReplayToReachesIntermediateStates(t *testing.T) {
+	const seed = 7
+	rng := rand.New(rand.NewSource(1))
+	g := New(WithSeed(seed))
+
+	// Play a game, remembering the position after each action.
+	actions := [][]byte{}
+	hashes := []uint64{g.Hash()}
+	roundNumbers := []int{g.RoundNumber}
+	for i := 0; i < 300 && !g.IsGameEnded; i++ {
+		action := randomAction(rng, g)
+		require.NoError(t, g.RunAction(action))
+		actions = append(actions, SerializeAction(action))
+		hashes = append(hashes, g.Hash())
+		roundNumbers = append(roundNumbers, g.RoundNumber)
+	}
+	require.Greater(t, roundNumbers[len(roundNumbers)-1], 1, "the game should span several rounds")
+
+	for _, index := range []int{0, 1, 2, 3, len(actions) / 3, len(actions) / 2, len(actions) - 1, len(actions)} {
+		replayed, err := ReplayTo(seed, actions, index)
+		require.NoError(t, err, index)
+		require.Equal(t, hashes[index], replayed.Hash(), index)
+		require.Equal(t, roundNumbers[index], replayed.RoundNumber, index)
+	}
+
+	replayed, err := Replay(seed, actions)
+	require.NoError(t, err)
+	require.Equal(t, g.Hash(), replayed.Hash())
+	require.Equal(t, g.Players[0].Score, replayed.Players[0].Score)
+	require.Equal(t, g.Players[1].Score, replayed.Players[1].Score)
+}
+
+func TestReplayToRejectsInvalidIndices(t *testing.T) {
+	actions := [][]byte{SerializeAction(NewActionDrawFromDrawPile(1))}
+
+	for _, index := range []int{-1, 2} {
+		_, err := ReplayTo(7, actions, index)
+		require.ErrorIs(t, err, errInvalidReplayIndex, index)
+	}
+}
+
+func TestReplayToFailsOnActionsThatDontReproduce(t *testing.T) {
+	g := New(WithSeed(7))
+	// The opponent of the player to move can't draw.
+	actions := [][]byte{SerializeAction(NewActionDrawFromDrawPile(g.TurnOpponentPlayerID))}
+
+	_, err := ReplayTo(7, actions, 1)
+	require.ErrorIs(t, err, ErrNotYourTurn)
+
+	_, err = ReplayTo(7, [][]byte{[]byte(`{"name":"unknown"}`)}, 1)
+	require.Error(t, err)
+}
+
+func TestReplayGameReproducesFinishedGames(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []func(*GameState)
+	}{
+		{name: "default"},
+		{name: "random_cut", opts: []func(*GameState){WithCut(CutRandom)}},
+		{name: "cut_by_opponent", opts: []func(*GameState){WithCut(CutByOpponent)}},
+		{name: "auto_discard", opts: []func(*GameState){WithAutoDiscardSingleOption(true), WithHandSize(4)}},
+		{name: "compacted", opts: []func(*GameState){WithCompactFinishedRounds(true)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 5; seed++ {
+				g := playSeededGame(t, seed, tt.opts...)
+				require.True(t, g.IsGameEnded)
+
+				replayed, err := ReplayGame(g.RoundsLog, append([]func(*GameState){WithSeed(seed)}, tt.opts...)...)
+				require.NoError(t, err)
+				requireSameState(t, g, replayed)
+			}
+		})
+	}
+}
+
+func TestReplayGameDoesntNeedTheSeed(t *testing.T) {
+	g := playSeededGame(t, 2)
+
+	// Rounds whose draw pile was reshuffled can't be reproduced without the seed.
+	rounds := 1
+	for rounds < len(g.RoundsLog) && g.RoundsLog[rounds].DrawPileReshuffles == 0 {
+		rounds++
+	}
+	require.Greater(t, rounds, 1)
+
+	replayed, err := ReplayGame(g.RoundsLog[:rounds])
+	require.NoError(t, err)
+	expected, err := json.Marshal(g.RoundsLog[1:rounds])
+	require.NoError(t, err)
+	actual, err := json.Marshal(replayed.RoundsLog[1:])
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual))
+}
+
+func TestReplayGameFailsOnLogsThatDontReproduce(t *testing.T) {
+	finished := playSeededGame(t, 1)
+
+	tests := []struct {
+		name     string
+		tamper   func(logs []*RoundLog)
+		expected error
+	}{
+		{
+			name:     "different_points",
+			tamper:   func(logs []*RoundLog) { logs[1].PointsAwarded++ },
+			expected: errReplayMismatch,
+		},
+		{
+			name:     "different_winner",
+			tamper:   func(logs []*RoundLog) { logs[1].WinnerPlayerID = logs[1].LoserPlayerID },
+			expected: errReplayMismatch,
+		},
+		{
+			name:     "missing_knock",
+			tamper:   func(logs []*RoundLog) { logs[1].ActionsLog = logs[1].ActionsLog[:len(logs[1].ActionsLog)-1] },
+			expected: errReplayMismatch,
+		},
+		{
+			name: "swapped_hands",
+			tamper: func(logs []*RoundLog) {
+				logs[1].HandsDealt[0], logs[1].HandsDealt[1] = logs[1].HandsDealt[1], logs[1].HandsDealt[0]
+			},
+			expected: errReplayMismatch,
+		},
+		{
+			name:     "unfinished_round",
+			tamper:   func(logs []*RoundLog) { logs[1].DeckOrder = nil },
+			expected: errInvalidRoundsLog,
+		},
+		{
+			name:     "missing_hand",
+			tamper:   func(logs []*RoundLog) { delete(logs[1].HandsDealt, 0) },
+			expected: errInvalidRoundsLog,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := finished.Clone()
+			tt.tamper(g.RoundsLog)
+			_, err := ReplayGame(g.RoundsLog, WithSeed(1))
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	t.Run("no_rounds", func(t *testing.T) {
+		_, err := ReplayGame([]*RoundLog{{}})
+		require.ErrorIs(t, err, errInvalidRoundsLog)
+	})
+
+	t.Run("unseeded_reshuffle", func(t *testing.T) {
+		g := finished.Clone()
+		g.RoundsLog[1].DrawPileReshuffles = 1
+		_, err := ReplayGame(g.RoundsLog)
+		require.ErrorIs(t, err, errInvalidRoundsLog)
+	})
+}
@@ -0,0 +1,52 @@
+package chinchon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalPilesAreCapturedWhenTheRoundFinishes(t *testing.T) {
+	g := New()
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+	discardAndEndTurn(t, g, g.Players[g.TurnPlayerID].Hand.Revealed[0])
+	require.Nil(t, g.RoundsLog[1].FinalDiscardPile)
+
+	discardPile := append([]Card{}, g.DiscardPile.Cards...)
+	drawPileCount := len(g.DrawPile.Cards)
+	knockWinningRound(t, g)
+
+	require.Equal(t, discardPile, g.RoundsLog[1].FinalDiscardPile)
+	require.Equal(t, drawPileCount, g.RoundsLog[1].FinalDrawPileCount)
+
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(0)))
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(1)))
+	require.Equal(t, 2, g.RoundNumber)
+	require.Equal(t, discardPile, g.RoundsLog[1].FinalDiscardPile)
+	require.Nil(t, g.RoundsLog[2].FinalDiscardPile)
+}
+
+func TestEveryRoundIsDealtFromAFullDeck(t *testing.T) {
+	for _, deckSize := range []int{40, 48} {
+		rng := rand.New(rand.NewSource(1))
+		g := New(WithDeckSize(deckSize), WithMaxPoints(1000))
+		for g.RoundNumber < 5 {
+			for i := 0; i < 10 && !g.IsRoundFinished; i++ {
+				require.NoError(t, g.RunAction(randomAction(rng, g)))
+			}
+			if !g.IsRoundFinished {
+				knockWinningRound(t, g)
+			}
+			require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+			require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+
+			cards := append(append([]Card{}, g.DrawPile.Cards...), g.DiscardPile.Cards...)
+			for _, player := range g.Players {
+				cards = append(cards, player.Hand.Revealed...)
+				require.Empty(t, player.Melds)
+			}
+			require.ElementsMatch(t, spanishCards(deckSize), cards, "round %v", g.RoundNumber)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package chinchon
+
+// WithRoundPointsCap limits the points a single round can award to max, e.g. so that a blowout
+// round can't decide the game on its own. A cap of zero (the default) disables the rule.
+func WithRoundPointsCap(max int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleRoundPointsCap = max
+	}
+}
+
+// WithRoundPointsRounding rounds the points awarded each round to the nearest multiple, e.g. 5,
+// with halves rounding up. A multiple of zero or one (the default) disables the rule.
+func WithRoundPointsRounding(multiple int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleRoundPointsRounding = multiple
+	}
+}
+
+// limitRoundPoints applies the round points rounding and then the cap to the points a round
+// awards, so that the cap holds even if it isn't a multiple of the rounding.
+func (g GameState) limitRoundPoints(points int) int {
+	if multiple := g.RuleRoundPointsRounding; multiple > 1 {
+		points = (points + multiple/2) / multiple * multiple
+	}
+	if g.RuleRoundPointsCap > 0 && points > g.RuleRoundPointsCap {
+		points = g.RuleRoundPointsCap
+	}
+	return points
+}
@@ -0,0 +1,34 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundPointsLimits(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           []func(*GameState)
+		expectedPoints int
+	}{
+		{name: "disabled_by_default", expectedPoints: 60},
+		{name: "capped", opts: []func(*GameState){WithRoundPointsCap(30)}, expectedPoints: 30},
+		{name: "under_the_cap", opts: []func(*GameState){WithRoundPointsCap(90)}, expectedPoints: 60},
+		{name: "rounded_down", opts: []func(*GameState){WithRoundPointsRounding(25)}, expectedPoints: 50},
+		{name: "rounded_up", opts: []func(*GameState){WithRoundPointsRounding(8)}, expectedPoints: 64},
+		{name: "halves_round_up", opts: []func(*GameState){WithRoundPointsRounding(40)}, expectedPoints: 80},
+		{name: "rounded_then_capped", opts: []func(*GameState){WithRoundPointsRounding(7), WithRoundPointsCap(50)}, expectedPoints: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+			winner := gameState.TurnPlayerID
+			knockWinningRound(t, gameState)
+
+			require.Equal(t, tt.expectedPoints, gameState.RoundsLog[gameState.RoundNumber].PointsAwarded)
+			require.Equal(t, tt.expectedPoints, gameState.Players[winner].Score)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package chinchon
+
+// RoundSummary is the outcome of a finished round: everything an end-of-round screen needs.
+type RoundSummary struct {
+	// WinnerPlayerID is the player who won the round, or -1 if it was a draw (see RoundLog.IsDraw).
+	WinnerPlayerID int `json:"winnerPlayerID"`
+
+	// KnockedPlayerID is the player who knocked to end the round.
+	KnockedPlayerID int `json:"knockedPlayerID"`
+
+	// PointsAwarded is the number of points awarded to the winner.
+	PointsAwarded int `json:"pointsAwarded"`
+
+	// MeldValues maps each player ID to their melds at the end of the round, along with the
+	// deadwood points each meld saved them.
+	MeldValues map[int][]MeldValue `json:"meldValues"`
+
+	// Hands maps each player ID to the cards left in their hand at the end of the round, and
+	// DeadwoodPoints to the deadwood they were scored with, so that results can show both hands.
+	// Hands are only revealed here, once the round is finished.
+	Hands          map[int][]Card `json:"hands"`
+	DeadwoodPoints map[int]int    `json:"deadwoodPoints"`
+}
+
+// MeldValue is a meld along with the total deadwood value of its cards, e.g. 18 points for a 5-6-7
+// run, which is what melding them saved.
+type MeldValue struct {
+	Meld   *Meld `json:"meld"`
+	Points int   `json:"points"`
+}
+
+// roundSummary returns the outcome of the current round, or nil if the round isn't finished.
+func (g GameState) roundSummary() *RoundSummary {
+	if !g.IsRoundFinished {
+		return nil
+	}
+	roundLog := g.RoundsLog[g.RoundNumber]
+	summary := &RoundSummary{
+		WinnerPlayerID:  roundLog.WinnerPlayerID,
+		KnockedPlayerID: roundLog.KnockedPlayerID,
+		PointsAwarded:   roundLog.PointsAwarded,
+		MeldValues:      map[int][]MeldValue{},
+		Hands:           map[int][]Card{},
+		DeadwoodPoints:  map[int]int{},
+	}
+	for playerID, player := range g.Players {
+		hand := append([]Card{}, player.Hand.cards()...)
+		summary.Hands[playerID] = hand
+		summary.DeadwoodPoints[playerID] = g.BestDeadwood(playerID)
+	}
+	for playerID, melds := range roundLog.MeldsDealt {
+		values := []MeldValue{}
+		for _, meld := range melds {
+			values = append(values, MeldValue{Meld: meld, Points: g.meldPoints(meld)})
+		}
+		summary.MeldValues[playerID] = values
+	}
+	return summary
+}
+
+// meldPoints returns the total deadwood value of the meld's cards.
+func (g GameState) meldPoints(meld *Meld) int {
+	points := 0
+	for _, card := range meld.Cards {
+		points += g.cardValue(card)
+	}
+	return points
+}
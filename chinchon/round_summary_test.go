@@ -0,0 +1,65 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundSummaryMeldValues(t *testing.T) {
+	gameState := New()
+	knocker, opponent := gameState.TurnPlayerID, gameState.TurnOpponentPlayerID
+	require.Nil(t, gameState.ToClientGameState(knocker).RoundSummary)
+
+	readyToKnock(gameState)
+	ones := &Meld{Type: MeldTypeSet, Cards: []Card{{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}, {Suit: BASTO, Number: 1}}}
+	twos := &Meld{Type: MeldTypeSet, Cards: []Card{{Suit: ORO, Number: 2}, {Suit: COPA, Number: 2}, {Suit: ESPADA, Number: 2}}}
+	gameState.Players[knocker].Melds = []*Meld{ones, twos}
+	run := &Meld{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: ORO, Number: 7}}}
+	gameState.Players[opponent].Melds = []*Meld{run}
+	require.NoError(t, gameState.RunAction(NewActionKnock(knocker)))
+
+	summary := gameState.ToClientGameState(knocker).RoundSummary
+	require.NotNil(t, summary)
+	require.Equal(t, knocker, summary.KnockedPlayerID)
+	require.Equal(t, []MeldValue{{Meld: ones, Points: 4}, {Meld: twos, Points: 6}}, summary.MeldValues[knocker])
+	require.Equal(t, []MeldValue{{Meld: run, Points: 18}}, summary.MeldValues[opponent])
+
+	// The meld values add up to the points the melded cards would otherwise have counted as deadwood.
+	for playerID, values := range summary.MeldValues {
+		total := 0
+		for _, value := range values {
+			total += value.Points
+		}
+		melded := 0
+		for _, meld := range gameState.Players[playerID].Melds {
+			for _, card := range meld.Cards {
+				melded += card.DeadwoodValue()
+			}
+		}
+		require.Equal(t, melded, total)
+	}
+}
+
+func TestRoundSummaryRevealsHandsOnlyOnceTheRoundIsFinished(t *testing.T) {
+	gameState := New(WithMaxPoints(1000))
+	knocker, opponent := gameState.TurnPlayerID, gameState.TurnOpponentPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(knocker)))
+	for playerID := range gameState.Players {
+		require.Nil(t, gameState.ToClientGameState(playerID).RoundSummary)
+	}
+
+	knockWinningRound(t, gameState)
+	summary := gameState.ToClientGameState(knocker).RoundSummary
+	require.NotNil(t, summary)
+	require.Equal(t, gameState.Players[opponent].Hand.Revealed, summary.Hands[opponent])
+	require.Equal(t, 67, summary.DeadwoodPoints[opponent])
+	require.Equal(t, gameState.Players[knocker].Hand.Revealed, summary.Hands[knocker])
+	require.Equal(t, 7, summary.DeadwoodPoints[knocker])
+	require.Equal(t, summary, gameState.ToClientGameState(opponent).RoundSummary)
+
+	// Once both players confirm, the next round starts and its hands are hidden again.
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(gameState.TurnPlayerID)))
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(gameState.TurnPlayerID)))
+	require.Nil(t, gameState.ToClientGameState(knocker).RoundSummary)
+}
@@ -0,0 +1,117 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidRules is returned by Rules.Validate for rules that can't be played.
+var ErrInvalidRules = errors.New("invalid rules")
+
+// Rules are the rules that change how a game plays out, as opposed to settings like action logging
+// or analysis mode. Players must agree on them before playing a game over the network (see the
+// server's rules negotiation), so they're comparable with ==.
+type Rules struct {
+	MaxPoints            int     `json:"maxPoints"`
+	HandSize             int     `json:"handSize"`
+	KnockThreshold       int     `json:"knockThreshold"`
+	GinBonus             int     `json:"ginBonus"`
+	UndercutBonus        int     `json:"undercutBonus"`
+	ChinchonBonus        int     `json:"chinchonBonus"`
+	DeckSize             int     `json:"deckSize"`
+	InitialDiscardCount  int     `json:"initialDiscardCount"`
+	DeclinedKnockPenalty int     `json:"declinedKnockPenalty"`
+	BestOf               int     `json:"bestOf"`
+	AceWrap              AceWrap `json:"aceWrap"`
+	NoFirstTurnKnock     bool    `json:"noFirstTurnKnock"`
+	ChinchonEndsGame     bool    `json:"chinchonEndsGame"`
+	IsSubtractiveScoring bool    `json:"isSubtractiveScoring"`
+	IsStrictDiscardDraw  bool    `json:"isStrictDiscardDraw"`
+	IsOpenHands          bool    `json:"isOpenHands"`
+}
+
+// DefaultRules returns the rules of a game created without options.
+func DefaultRules() Rules {
+	return Rules{
+		MaxPoints:           DefaultMaxPoints,
+		HandSize:            DefaultHandSize,
+		KnockThreshold:      DefaultKnockThreshold,
+		GinBonus:            DefaultGinBonus,
+		UndercutBonus:       DefaultUndercutBonus,
+		ChinchonBonus:       DefaultChinchonBonus,
+		DeckSize:            DefaultDeckSize,
+		InitialDiscardCount: DefaultInitialDiscardCount,
+	}
+}
+
+// Validate returns an error wrapping ErrInvalidRules if the rules can't be played, e.g. a hand
+// size that can't be dealt. New ignores such options, so rules received from untrusted clients
+// should be validated rather than silently replaced by the defaults. Hands are checked against a
+// game of MinPlayers.
+func (r Rules) Validate() error {
+	switch {
+	case r.MaxPoints < 1:
+		return fmt.Errorf("%w: max points must be positive, got %v", ErrInvalidRules, r.MaxPoints)
+	case r.DeckSize != DefaultDeckSize && r.DeckSize != 48:
+		return fmt.Errorf("%w: deck size must be %v or 48, got %v", ErrInvalidRules, DefaultDeckSize, r.DeckSize)
+	case r.HandSize < 1:
+		return fmt.Errorf("%w: hand size must be positive, got %v", ErrInvalidRules, r.HandSize)
+	case r.InitialDiscardCount < 0:
+		return fmt.Errorf("%w: initial discard count can't be negative, got %v", ErrInvalidRules, r.InitialDiscardCount)
+	case MinPlayers*r.HandSize+r.InitialDiscardCount >= r.DeckSize:
+		return fmt.Errorf("%w: hand size %v with %v initial discards leaves no cards to draw", ErrInvalidRules, r.HandSize, r.InitialDiscardCount)
+	case r.KnockThreshold < 0:
+		return fmt.Errorf("%w: knock threshold can't be negative, got %v", ErrInvalidRules, r.KnockThreshold)
+	case r.GinBonus < 0, r.UndercutBonus < 0, r.ChinchonBonus < 0, r.DeclinedKnockPenalty < 0:
+		return fmt.Errorf("%w: bonuses and penalties can't be negative", ErrInvalidRules)
+	case r.BestOf < 0:
+		return fmt.Errorf("%w: best of can't be negative, got %v", ErrInvalidRules, r.BestOf)
+	case r.AceWrap < AceWrapNone || r.AceWrap > AceWrapHighAllowed:
+		return fmt.Errorf("%w: unknown ace wrap %v", ErrInvalidRules, r.AceWrap)
+	}
+	return nil
+}
+
+// Options returns the options that create a game with these rules, e.g. New(rules.Options()...).
+func (r Rules) Options() []func(*GameState) {
+	return []func(*GameState){
+		WithMaxPoints(r.MaxPoints),
+		WithHandSize(r.HandSize),
+		WithKnockThreshold(r.KnockThreshold),
+		WithGinBonus(r.GinBonus),
+		WithUndercutBonus(r.UndercutBonus),
+		WithChinchonBonus(r.ChinchonBonus),
+		WithDeckSize(r.DeckSize),
+		WithInitialDiscardCount(r.InitialDiscardCount),
+		WithDeclinedKnockPenalty(r.DeclinedKnockPenalty),
+		WithBestOf(r.BestOf),
+		WithAceWrap(r.AceWrap),
+		WithNoFirstTurnKnock(r.NoFirstTurnKnock),
+		WithChinchonEndsGame(r.ChinchonEndsGame),
+		WithSubtractiveScoring(r.IsSubtractiveScoring),
+		WithStrictDiscardDraw(r.IsStrictDiscardDraw),
+		WithOpenHands(r.IsOpenHands),
+	}
+}
+
+// Rules returns the rules the game is played with.
+func (g GameState) Rules() Rules {
+	return Rules{
+		MaxPoints:            g.RuleMaxPoints,
+		HandSize:             g.RuleHandSize,
+		KnockThreshold:       g.RuleKnockThreshold,
+		GinBonus:             g.RuleGinBonus,
+		UndercutBonus:        g.RuleUndercutBonus,
+		ChinchonBonus:        g.RuleChinchonBonus,
+		DeckSize:             g.RuleDeckSize,
+		InitialDiscardCount:  g.RuleInitialDiscardCount,
+		DeclinedKnockPenalty: g.RuleDeclinedKnockPenalty,
+		BestOf:               g.RuleBestOf,
+		AceWrap:              g.RuleAceWrap,
+		NoFirstTurnKnock:     g.RuleNoFirstTurnKnock,
+		ChinchonEndsGame:     g.RuleChinchonEndsGame,
+		IsSubtractiveScoring: g.RuleIsSubtractiveScoring,
+		IsStrictDiscardDraw:  g.RuleIsStrictDiscardDraw,
+		IsOpenHands:          g.RuleIsOpenHands,
+	}
+}
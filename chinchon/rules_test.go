@@ -0,0 +1,54 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRulesAreTheRulesOfANewGame(t *testing.T) {
+	require.Equal(t, DefaultRules(), New().Rules())
+}
+
+func TestRulesOptionsCreateAGameWithTheRules(t *testing.T) {
+	rules := DefaultRules()
+	rules.MaxPoints = 50
+	rules.DeckSize = 48
+	rules.AceWrap = AceWrapLowOnly
+	rules.IsStrictDiscardDraw = true
+
+	g := New(rules.Options()...)
+
+	require.Equal(t, rules, g.Rules())
+	require.Len(t, g.DrawPile.Cards, 48-2*DefaultHandSize-DefaultInitialDiscardCount)
+}
+
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Rules)
+	}{
+		{name: "zero max points", modify: func(r *Rules) { r.MaxPoints = 0 }},
+		{name: "negative max points", modify: func(r *Rules) { r.MaxPoints = -100 }},
+		{name: "unknown deck size", modify: func(r *Rules) { r.DeckSize = 52 }},
+		{name: "zero hand size", modify: func(r *Rules) { r.HandSize = 0 }},
+		{name: "hand size larger than the deck", modify: func(r *Rules) { r.HandSize = 30 }},
+		{name: "no cards left to draw", modify: func(r *Rules) { r.HandSize = 19; r.InitialDiscardCount = 2 }},
+		{name: "negative initial discard count", modify: func(r *Rules) { r.InitialDiscardCount = -1 }},
+		{name: "negative knock threshold", modify: func(r *Rules) { r.KnockThreshold = -1 }},
+		{name: "negative gin bonus", modify: func(r *Rules) { r.GinBonus = -25 }},
+		{name: "negative declined knock penalty", modify: func(r *Rules) { r.DeclinedKnockPenalty = -5 }},
+		{name: "negative best of", modify: func(r *Rules) { r.BestOf = -3 }},
+		{name: "unknown ace wrap", modify: func(r *Rules) { r.AceWrap = AceWrapHighAllowed + 1 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultRules()
+			tt.modify(&rules)
+			require.ErrorIs(t, rules.Validate(), ErrInvalidRules)
+		})
+	}
+
+	require.NoError(t, DefaultRules().Validate())
+	require.Error(t, Rules{}.Validate())
+}
@@ -0,0 +1,59 @@
+package chinchon
+
+import "strconv"
+
+// Scorecard returns the game's scores laid out like a paper Chinchón scoresheet, for printing. The
+// first row is the header; then there's a row per finished round, with the round number followed by
+// each player's points for that round and running total, in PlayerOrder. A player's first total is
+// their starting score, so totals also match under subtractive scoring or starting scores.
+//
+// It's derived from RoundsLog, so it can be rebuilt for any game, e.g. one being replayed. Like the
+// players' scores, totals stop at the winning score, unless playing a best-of series.
+func (g GameState) Scorecard() [][]string {
+	header := []string{"Round"}
+	totals := map[int]int{}
+	for _, playerID := range g.PlayerOrder {
+		header = append(header, "Player "+strconv.Itoa(playerID), "Total")
+		totals[playerID] = g.initialScore()
+		if score, ok := g.RuleStartingScores[playerID]; ok && g.isValidStartingScore(score) {
+			totals[playerID] = score
+		}
+	}
+	scorecard := [][]string{header}
+
+	for roundNumber := 1; roundNumber < len(g.RoundsLog); roundNumber++ {
+		if roundNumber == g.RoundNumber && !g.IsRoundFinished {
+			break
+		}
+		roundLog := g.RoundsLog[roundNumber]
+		row := []string{strconv.Itoa(roundNumber)}
+		for _, playerID := range g.PlayerOrder {
+			points := 0
+			if playerID == roundLog.WinnerPlayerID {
+				points = roundLog.PointsAwarded
+			}
+			totals[playerID] = g.scorecardTotal(totals[playerID], points)
+			row = append(row, strconv.Itoa(points), strconv.Itoa(totals[playerID]))
+		}
+		scorecard = append(scorecard, row)
+	}
+	return scorecard
+}
+
+// scorecardTotal returns the running total after being awarded points, stopping at the winning
+// score like the players' scores do when the game ends.
+func (g GameState) scorecardTotal(total, points int) int {
+	if g.RuleIsSubtractiveScoring {
+		total -= points
+	} else {
+		total += points
+	}
+	switch {
+	case g.RuleBestOf > 0:
+		return total
+	case g.RuleIsSubtractiveScoring:
+		return max(total, g.winningScore())
+	default:
+		return min(total, g.winningScore())
+	}
+}
@@ -0,0 +1,77 @@
+package chinchon
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// scorecardFinalTotals returns each player's total in the scorecard's last row.
+func scorecardFinalTotals(g *GameState) map[int]int {
+	scorecard := g.Scorecard()
+	lastRow := scorecard[len(scorecard)-1]
+	totals := map[int]int{}
+	for i, playerID := range g.PlayerOrder {
+		total, _ := strconv.Atoi(lastRow[2+2*i])
+		totals[playerID] = total
+	}
+	return totals
+}
+
+func TestScorecardLaysOutEachRound(t *testing.T) {
+	g := New(WithMaxPoints(1000))
+	g.PlayerOrder = []int{1, 0}
+	require.Equal(t, [][]string{{"Round", "Player 1", "Total", "Player 0", "Total"}}, g.Scorecard())
+
+	winRound(t, g, 0)
+	winRound(t, g, 1)
+	winRound(t, g, 0)
+
+	require.Equal(t, [][]string{
+		{"Round", "Player 1", "Total", "Player 0", "Total"},
+		{"1", "0", "0", "60", "60"},
+		{"2", "60", "60", "0", "60"},
+		{"3", "0", "60", "60", "120"},
+	}, g.Scorecard())
+}
+
+func TestScorecardSkipsTheRoundInProgress(t *testing.T) {
+	g := New()
+	knockWinningRound(t, g)
+	require.Len(t, g.Scorecard(), 2)
+
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+	require.NoError(t, g.RunAction(NewActionConfirmRoundFinished(g.TurnPlayerID)))
+	require.Len(t, g.Scorecard(), 2)
+}
+
+func TestScorecardTotalsMatchFinalScores(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []func(*GameState)
+	}{
+		{name: "default"},
+		{name: "subtractive", opts: []func(*GameState){WithSubtractiveScoring(true)}},
+		{name: "starting_scores", opts: []func(*GameState){WithStartingScores(map[int]int{1: 40})}},
+		{name: "best_of", opts: []func(*GameState){WithBestOf(5)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checked := 0
+			for seed := int64(0); seed < 20; seed++ {
+				rng := rand.New(rand.NewSource(seed))
+				g := New(append([]func(*GameState){WithSeed(seed)}, tt.opts...)...)
+				playRandomGame(t, rng, g, 5000, func(map[int]int, Action) {
+					if g.IsRoundFinished {
+						checked++
+						require.Equal(t, map[int]int{0: g.Players[0].Score, 1: g.Players[1].Score}, scorecardFinalTotals(g), "seed %v", seed)
+					}
+				})
+			}
+			require.NotZero(t, checked)
+		})
+	}
+}
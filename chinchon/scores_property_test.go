@@ -0,0 +1,58 @@
+package chinchon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// randomAction picks one of the currently possible actions other than forfeiting uniformly at
+// random.
+func randomAction(rng *rand.Rand, g *GameState) Action {
+	actions := []Action{}
+	for _, action := range g.CalculatePossibleActions() {
+		if !isForfeit(action) {
+			actions = append(actions, action)
+		}
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+	return actions[rng.Intn(len(actions))]
+}
+
+// playRandomGame plays random actions until the game ends or maxActions have been run, calling
+// afterEach with the scores before and after each action.
+func playRandomGame(t testing.TB, rng *rand.Rand, g *GameState, maxActions int, afterEach func(before map[int]int, action Action)) {
+	for i := 0; i < maxActions && !g.IsGameEnded; i++ {
+		before := map[int]int{}
+		for playerID, player := range g.Players {
+			before[playerID] = player.Score
+		}
+		action := randomAction(rng, g)
+		require.NotNil(t, action, "no possible actions at step %v", i)
+		require.NoError(t, g.RunAction(action), "running %v at step %v", action, i)
+		afterEach(before, action)
+	}
+}
+
+// FuzzScoresNeverDecrease asserts that no action ever decreases a player's score, since points
+// are only ever added. Scoring variants that subtract points must gate this invariant.
+func FuzzScoresNeverDecrease(f *testing.F) {
+	for _, seed := range []int64{1, 2, 3, 42, 1234} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Logf("seed: %v", seed)
+		rng := rand.New(rand.NewSource(seed))
+		gameState := New(WithSeed(seed), WithMaxPoints(30))
+		playRandomGame(t, rng, gameState, 5000, func(before map[int]int, action Action) {
+			for playerID, player := range gameState.Players {
+				if player.Score < before[playerID] {
+					t.Fatalf("player %v's score decreased from %v to %v after %v", playerID, before[playerID], player.Score, action)
+				}
+			}
+		})
+	})
+}
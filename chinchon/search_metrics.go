@@ -0,0 +1,32 @@
+package chinchon
+
+// BranchingFactor returns the number of currently legal actions, i.e. the number of children of
+// this position in the game tree. It's zero once the game has ended.
+func (g GameState) BranchingFactor() int {
+	if g.IsGameEnded {
+		return 0
+	}
+	return len(g.CalculatePossibleActions())
+}
+
+// EstimateGameTreeDepth returns a rough estimate of how many more actions the current round can
+// last, to gauge whether searching it is feasible. It is an estimate, not a bound.
+//
+// It assumes players keep drawing from the draw pile until it runs out, with each turn taking a
+// draw and a discard, and that each player eventually melds their hand in melds of three cards
+// before someone knocks.
+func (g GameState) EstimateGameTreeDepth() int {
+	if g.IsGameEnded || g.IsRoundFinished {
+		return 0
+	}
+
+	depth := 2 * len(g.DrawPile.cards())
+	if g.HasDrawnThisTurn && !g.HasDiscardedThisTurn {
+		depth++
+	}
+	for _, player := range g.Players {
+		depth += len(player.Hand.cards()) / 3
+	}
+	// The knock that ends the round.
+	return depth + 1
+}
@@ -0,0 +1,37 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchingFactorMatchesLegalActions(t *testing.T) {
+	gameState := New()
+	require.Equal(t, 3, gameState.BranchingFactor(), "draw from either pile, or forfeit")
+
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(gameState.TurnPlayerID)))
+	require.Equal(t, len(gameState.CalculatePossibleActions()), gameState.BranchingFactor())
+	require.Equal(t, DefaultHandSize+2, gameState.BranchingFactor(), "discard any card, or forfeit")
+}
+
+func TestBranchingFactorIsZeroWhenGameEnded(t *testing.T) {
+	gameState := New()
+	gameState.IsRoundFinished = true
+	gameState.IsGameEnded = true
+
+	require.Zero(t, gameState.BranchingFactor())
+	require.Zero(t, gameState.EstimateGameTreeDepth())
+}
+
+func TestEstimateGameTreeDepth(t *testing.T) {
+	gameState := New()
+	drawPile := len(gameState.DrawPile.Cards)
+
+	// Two actions per draw pile card, two melds per player and the final knock.
+	require.Equal(t, 2*drawPile+2+2+1, gameState.EstimateGameTreeDepth())
+
+	// Drawing takes a card from the draw pile but leaves a pending discard.
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(gameState.TurnPlayerID)))
+	require.Equal(t, 2*(drawPile-1)+1+2+2+1, gameState.EstimateGameTreeDepth())
+}
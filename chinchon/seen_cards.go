@@ -0,0 +1,66 @@
+package chinchon
+
+// seenCards returns every card the player has definitively seen this round, in deck order.
+//
+// These are the player's dealt hand, the cards face up on the discard pile at the start of the
+// round, every discarded card, the player's current hand (which includes the cards they drew),
+// and all melds on the table. The opponent's hand is never included, except for cards the player
+// saw on the discard pile before the opponent picked them up.
+//
+// Once the discard pile is reshuffled into the draw pile (see replenishDrawPile), the cards seen
+// before could be drawn again, so only the discards since the last reshuffle count, besides the
+// cards the player can still see.
+func (g GameState) seenCards(playerID int) []Card {
+	seen := map[Card]bool{}
+	see := func(cards []Card) {
+		for _, card := range cards {
+			seen[card] = true
+		}
+	}
+
+	roundLog := g.RoundsLog[g.RoundNumber]
+	actions := _deserializeCurrentRoundActions(g)
+	if roundLog.DrawPileReshuffles == 0 {
+		if hand, ok := roundLog.HandsDealt[playerID]; ok && hand != nil {
+			see(hand.Revealed)
+		}
+		see(roundLog.InitialDiscardPile)
+	} else {
+		actions = actions[min(roundLog.LastReshuffleActionCount, len(actions)):]
+	}
+	for _, action := range actions {
+		if discard, ok := action.(*ActionDiscardCard); ok {
+			see([]Card{discard.Card})
+		}
+	}
+	see(g.DiscardPile.cards())
+	if hand := g.Players[playerID].Hand; hand != nil {
+		see(hand.Revealed)
+	}
+	for _, player := range g.Players {
+		for _, meld := range player.Melds {
+			see(meld.Cards)
+		}
+	}
+
+	seenCards := []Card{}
+	for _, card := range spanishCards(g.RuleDeckSize) {
+		if seen[card] {
+			seenCards = append(seenCards, card)
+		}
+	}
+	return seenCards
+}
+
+// IsCardLive returns true if, as far as the player knows, the card could still be drawn: it's in
+// the deck, and the player hasn't seen it (see seenCards), e.g. in the discard pile, in their own
+// hand or in a meld on the table. Cards the opponent picked up after the player saw them aren't
+// live, since the player knows they're in the opponent's hand.
+func (g GameState) IsCardLive(playerID int, card Card) bool {
+	for _, unseen := range g.unseenCards(playerID) {
+		if unseen == card {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeenCards(t *testing.T) {
+	gameState := New()
+	you := gameState.TurnPlayerID
+	them := gameState.TurnOpponentPlayerID
+	initialDiscard, _ := gameState.DiscardPile.TopCard()
+
+	// You draw from the draw pile and discard a card, which they pick up.
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDrawPile(you)))
+	yourDiscard := gameState.Players[you].Hand.Revealed[0]
+	discardAndEndTurn(t, gameState, yourDiscard)
+	require.NoError(t, gameState.RunAction(NewActionDrawFromDiscardPile(them)))
+	theirDiscard := gameState.Players[them].Hand.Revealed[0]
+	discardAndEndTurn(t, gameState, theirDiscard)
+
+	seen := map[Card]bool{}
+	for _, card := range gameState.ToClientGameState(you).SeenCards {
+		seen[card] = true
+	}
+
+	require.True(t, seen[initialDiscard])
+	require.True(t, seen[yourDiscard])
+	require.True(t, seen[theirDiscard])
+	for _, card := range gameState.Players[you].Hand.Revealed {
+		require.True(t, seen[card], "own card %v should be seen", card)
+	}
+	for _, card := range gameState.Players[them].Hand.Revealed {
+		if card == yourDiscard || card == initialDiscard {
+			continue
+		}
+		require.False(t, seen[card], "opponent's hidden card %v should not be seen", card)
+	}
+	for _, card := range gameState.DrawPile.Cards {
+		require.False(t, seen[card], "draw pile card %v should not be seen", card)
+	}
+}
+
+func TestSeenCardsIncludesOpponentMelds(t *testing.T) {
+	gameState := New()
+	meld := &Meld{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}}}
+	gameState.Players[1].Melds = []*Meld{meld}
+
+	seen := map[Card]bool{}
+	for _, card := range gameState.ToClientGameState(0).SeenCards {
+		seen[card] = true
+	}
+	for _, card := range meld.Cards {
+		require.True(t, seen[card])
+	}
+}
+
+func TestIsCardLive(t *testing.T) {
+	gameState := New()
+	you := gameState.TurnPlayerID
+	them := gameState.TurnOpponentPlayerID
+
+	discarded, _ := gameState.DiscardPile.TopCard()
+	require.False(t, gameState.IsCardLive(you, discarded), "the discard pile is visible")
+	require.False(t, gameState.IsCardLive(you, gameState.Players[you].Hand.Revealed[0]), "own cards are known")
+	require.False(t, gameState.IsCardLive(you, Card{Suit: ORO, Number: 8}), "8s aren't in a 40-card deck")
+
+	unseen := gameState.DrawPile.Cards[0]
+	require.True(t, gameState.IsCardLive(you, unseen))
+	for _, card := range gameState.Players[them].Hand.Revealed {
+		require.True(t, gameState.IsCardLive(you, card), "the opponent's hand is hidden")
+	}
+
+	// Melds on the table are visible to both players.
+	melded := gameState.Players[them].Hand.Revealed[0]
+	gameState.Players[them].Melds = []*Meld{{Type: MeldTypeSet, Cards: []Card{melded}}}
+	require.False(t, gameState.IsCardLive(you, melded))
+}
+
+func TestReshuffledCardsAreLiveAgain(t *testing.T) {
+	gameState := New(WithSeed(1), WithTrainingMode(true))
+	you := gameState.TurnPlayerID
+	for gameState.RoundsLog[gameState.RoundNumber].DrawPileReshuffles == 0 {
+		drawAndDiscardTheDrawnCard(t, gameState, 1)
+	}
+	require.False(t, gameState.IsRoundFinished)
+
+	for _, card := range gameState.DrawPile.Cards {
+		require.True(t, gameState.IsCardLive(you, card), "reshuffled card %v could be drawn again", card)
+	}
+	remaining := 0
+	for _, count := range gameState.ToClientGameState(you).RemainingCardEstimate {
+		remaining += count
+	}
+	require.GreaterOrEqual(t, remaining, len(gameState.DrawPile.Cards))
+}
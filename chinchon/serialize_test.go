@@ -0,0 +1,36 @@
+package chinchon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeserializedGameContinuesIdentically(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	original := New(WithSeed(3))
+	for i := 0; i < 20; i++ {
+		require.NoError(t, original.RunAction(randomAction(rng, original)))
+	}
+
+	bs, err := original.Serialize()
+	require.NoError(t, err)
+	restored, err := Deserialize(bs)
+	require.NoError(t, err)
+	require.Empty(t, DiffStates(original, restored))
+
+	// Both games take the same random actions, through the following rounds.
+	originalRNG, restoredRNG := rand.New(rand.NewSource(2)), rand.New(rand.NewSource(2))
+	for i := 0; i < 300 && !original.IsGameEnded; i++ {
+		require.NoError(t, original.RunAction(randomAction(originalRNG, original)))
+		require.NoError(t, restored.RunAction(randomAction(restoredRNG, restored)))
+		require.Empty(t, DiffStates(original, restored), "after action %v", i)
+	}
+	require.Greater(t, original.RoundNumber, 1, "the game should span several rounds")
+}
+
+func TestDeserializeRejectsInvalidJSON(t *testing.T) {
+	_, err := Deserialize([]byte("{"))
+	require.Error(t, err)
+}
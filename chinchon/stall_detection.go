@@ -0,0 +1,35 @@
+package chinchon
+
+// WithStallDetection aborts the game, ending it without a winner, once the same position (same
+// hands, piles and turn player; see GameState.Hash) recurs more than n times within a round. This
+// protects simulation infrastructure from looping bots. Zero disables it.
+//
+// The action that repeats the position still runs, and RunAction doesn't fail: the stall is
+// reported through IsStallDetected, with IsGameEnded set and WinnerPlayerID -1.
+func WithStallDetection(n int) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleStallDetection = n
+	}
+}
+
+// detectStall counts the current position, and aborts the game if it recurred too many times,
+// returning true.
+func (g *GameState) detectStall() bool {
+	if g.RuleStallDetection <= 0 || g.IsRoundFinished || g.IsGameEnded {
+		return false
+	}
+	if g.positionCounts == nil {
+		g.positionCounts = map[uint64]int{}
+	}
+	hash := g.Hash()
+	g.positionCounts[hash]++
+	if g.positionCounts[hash] <= g.RuleStallDetection {
+		return false
+	}
+
+	g.IsStallDetected = true
+	g.IsGameEnded = true
+	g.WinnerPlayerID = -1
+	g.PossibleActions = _serializeActions([]Action{})
+	return true
+}
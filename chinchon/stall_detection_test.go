@@ -0,0 +1,70 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// loopingBot always takes the top discard and discards the card it took on its previous turn, so
+// that both players keep passing the same three cards around.
+type loopingBot struct {
+	taken, discard Card
+}
+
+func (b *loopingBot) ChooseAction(gs ClientGameState) Action {
+	for _, raw := range gs.PossibleActions {
+		action, _ := DeserializeAction(raw)
+		switch action.GetName() {
+		case DRAW_FROM_DISCARD_PILE:
+			b.taken, b.discard = gs.DiscardPileTopCard, b.taken
+			return action
+		case DISCARD_CARD:
+			if b.discard == (Card{}) {
+				return action
+			}
+			return NewActionDiscardCard(b.discard, gs.YouPlayerID)
+		case END_TURN:
+			return action
+		}
+	}
+	return nil
+}
+
+func TestStallDetectionAbortsLoopingGame(t *testing.T) {
+	gameState := New(WithStallDetection(3))
+	bots := map[int]Bot{0: &loopingBot{}, 1: &loopingBot{}}
+
+	for i := 0; i < 100 && !gameState.IsGameEnded; i++ {
+		require.NoError(t, gameState.RunAction(bots[gameState.TurnPlayerID].ChooseAction(gameState.ToClientGameState(gameState.TurnPlayerID))))
+	}
+
+	require.True(t, gameState.IsStallDetected)
+	require.True(t, gameState.IsGameEnded)
+	require.Equal(t, -1, gameState.WinnerPlayerID)
+	require.Zero(t, gameState.BranchingFactor())
+	require.ErrorIs(t, gameState.RunAction(NewActionDrawFromDrawPile(gameState.TurnPlayerID)), ErrGameIsEnded)
+}
+
+func TestLoopingGameIsNotAbortedWithoutStallDetection(t *testing.T) {
+	gameState := New()
+	bots := map[int]Bot{0: &loopingBot{}, 1: &loopingBot{}}
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, gameState.RunAction(bots[gameState.TurnPlayerID].ChooseAction(gameState.ToClientGameState(gameState.TurnPlayerID))))
+	}
+	require.False(t, gameState.IsStallDetected)
+}
+
+func TestHashIgnoresHistoryAndHandOrder(t *testing.T) {
+	gameState := New()
+	hash := gameState.Hash()
+
+	hand := gameState.Players[0].Hand.Revealed
+	hand[0], hand[1] = hand[1], hand[0]
+	gameState.RoundTurnNumber = 7
+	require.Equal(t, hash, gameState.Hash())
+
+	gameState.HasDrawnThisTurn = true
+	require.NotEqual(t, hash, gameState.Hash())
+}
@@ -0,0 +1,60 @@
+package chinchon
+
+// PlayerStats summarises a player's performance over a game.
+type PlayerStats struct {
+	// Score is the player's game score.
+	Score int `json:"score"`
+
+	// RoundsWon is the number of finished rounds the player won.
+	RoundsWon int `json:"roundsWon"`
+
+	// PointsWon is the total of points awarded to the player in rounds they won.
+	PointsWon int `json:"pointsWon"`
+
+	// Gins is the number of rounds the player won with zero deadwood points.
+	Gins int `json:"gins"`
+
+	// Undercuts is the number of rounds the player won after the opponent knocked.
+	Undercuts int `json:"undercuts"`
+}
+
+// GameStats summarises a game, computed from its RoundsLog.
+type GameStats struct {
+	// RoundsPlayed is the number of finished rounds, including draws.
+	RoundsPlayed int `json:"roundsPlayed"`
+
+	// Players maps each player ID to their stats.
+	Players map[int]PlayerStats `json:"players"`
+}
+
+// ComputeStats computes per-player statistics for the game. Only finished rounds are considered;
+// rounds that ended as a draw (see RoundLog.IsDraw) count as played, but no one won them.
+func ComputeStats(g *GameState) GameStats {
+	stats := GameStats{Players: map[int]PlayerStats{}}
+	for playerID, player := range g.Players {
+		stats.Players[playerID] = PlayerStats{Score: player.Score}
+	}
+
+	for roundNumber, roundLog := range g.RoundsLog[1:] {
+		if roundNumber+1 == g.RoundNumber && !g.IsRoundFinished {
+			continue
+		}
+		stats.RoundsPlayed++
+		if roundLog.WinnerPlayerID == -1 {
+			continue
+		}
+
+		winnerStats := stats.Players[roundLog.WinnerPlayerID]
+		winnerStats.RoundsWon++
+		winnerStats.PointsWon += roundLog.PointsAwarded
+		if roundLog.WinnerDeadwoodPoints == 0 {
+			winnerStats.Gins++
+		}
+		if roundLog.KnockedPlayerID != -1 && roundLog.KnockedPlayerID != roundLog.WinnerPlayerID {
+			winnerStats.Undercuts++
+		}
+		stats.Players[roundLog.WinnerPlayerID] = winnerStats
+	}
+
+	return stats
+}
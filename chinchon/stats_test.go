@@ -0,0 +1,59 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeStats(t *testing.T) {
+	gameState := New()
+	gameState.Players[0].Score = 40
+	gameState.Players[1].Score = 12
+	gameState.RoundsLog = []*RoundLog{
+		{},
+		{KnockedPlayerID: 0, WinnerPlayerID: 0, LoserPlayerID: 1, WinnerDeadwoodPoints: 0, LoserDeadwoodPoints: 15, PointsAwarded: 40},
+		{KnockedPlayerID: 0, WinnerPlayerID: 1, LoserPlayerID: 0, WinnerDeadwoodPoints: 3, LoserDeadwoodPoints: 5, PointsAwarded: 12},
+		{KnockedPlayerID: -1, WinnerPlayerID: -1, LoserPlayerID: -1}, // current, unfinished round
+	}
+	gameState.RoundNumber = 3
+
+	stats := ComputeStats(gameState)
+
+	require.Equal(t, 2, stats.RoundsPlayed)
+	require.Equal(t, PlayerStats{Score: 40, RoundsWon: 1, PointsWon: 40, Gins: 1}, stats.Players[0])
+	require.Equal(t, PlayerStats{Score: 12, RoundsWon: 1, PointsWon: 12, Undercuts: 1}, stats.Players[1])
+}
+
+func TestComputeStatsCountsDrawnRounds(t *testing.T) {
+	gameState := New(WithMaxActionsPerRound(20))
+	// Both hands have the same deadwood and no melds, so neither player can knock
+	gameState.Players[0].Hand.Revealed = []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 4}, {Suit: COPA, Number: 2}, {Suit: COPA, Number: 5},
+		{Suit: ESPADA, Number: 3}, {Suit: ESPADA, Number: 6}, {Suit: BASTO, Number: 12},
+	}
+	gameState.Players[1].Hand.Revealed = []Card{
+		{Suit: COPA, Number: 1}, {Suit: COPA, Number: 4}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 5},
+		{Suit: BASTO, Number: 3}, {Suit: BASTO, Number: 6}, {Suit: ESPADA, Number: 12},
+	}
+	for _, playerID := range gameState.PlayerOrder {
+		for _, card := range gameState.Players[playerID].Hand.Revealed {
+			gameState.DrawPile.Cards = without(gameState.DrawPile.Cards, card)
+			gameState.DiscardPile.Cards = without(gameState.DiscardPile.Cards, card)
+		}
+	}
+
+	drawAndDiscardTheDrawnCard(t, gameState, 100)
+
+	require.True(t, gameState.IsRoundFinished)
+	require.True(t, gameState.RoundsLog[1].IsDraw)
+	require.Equal(t, 1, ComputeStats(gameState).RoundsPlayed)
+
+	// The next round is unfinished, so it isn't counted
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(0)))
+	require.NoError(t, gameState.RunAction(NewActionConfirmRoundFinished(1)))
+	stats := ComputeStats(gameState)
+	require.Equal(t, 1, stats.RoundsPlayed)
+	require.Zero(t, stats.Players[0].RoundsWon)
+	require.Zero(t, stats.Players[1].RoundsWon)
+}
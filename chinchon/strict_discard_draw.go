@@ -0,0 +1,26 @@
+package chinchon
+
+// WithStrictDiscardDraw only lets players draw the top of the discard pile if it immediately forms
+// a meld with cards in their hand, or extends one of their melds. This stops players from hoarding
+// discards, e.g. to deny them to the opponent.
+func WithStrictDiscardDraw(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleIsStrictDiscardDraw = enabled
+	}
+}
+
+// completesMeld returns true if the card would be part of a valid meld once drawn by the player:
+// either along with cards in their hand, or extending one of their melds.
+func (g GameState) completesMeld(playerID int, card Card) bool {
+	hand := append(append([]Card{}, g.Players[playerID].Hand.cards()...), card)
+	if isMelded(card, g.candidateMelds(hand)) {
+		return true
+	}
+	for _, meld := range g.Players[playerID].Melds {
+		extended := &Meld{Type: meld.Type, Cards: append(append([]Card{}, meld.Cards...), card)}
+		if extended.IsValidWithAceWrap(g.RuleAceWrap) {
+			return true
+		}
+	}
+	return false
+}
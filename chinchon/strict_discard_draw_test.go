@@ -0,0 +1,46 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictDiscardDraw(t *testing.T) {
+	hand := []Card{
+		{Suit: ORO, Number: 5}, {Suit: ORO, Number: 6}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 3},
+		{Suit: BASTO, Number: 10}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 1},
+	}
+	tests := []struct {
+		name     string
+		opts     []func(*GameState)
+		melds    []*Meld
+		top      Card
+		expected bool
+	}{
+		{name: "completes_a_run", opts: []func(*GameState){WithStrictDiscardDraw(true)}, top: Card{Suit: ORO, Number: 7}, expected: true},
+		{name: "completes_a_set", opts: []func(*GameState){WithStrictDiscardDraw(true)}, top: Card{Suit: BASTO, Number: 3}, expected: true},
+		{
+			name:     "extends_a_meld",
+			opts:     []func(*GameState){WithStrictDiscardDraw(true)},
+			melds:    []*Meld{{Type: MeldTypeRun, Cards: []Card{{Suit: BASTO, Number: 4}, {Suit: BASTO, Number: 5}, {Suit: BASTO, Number: 6}}}},
+			top:      Card{Suit: BASTO, Number: 7},
+			expected: true,
+		},
+		{name: "useless_card", opts: []func(*GameState){WithStrictDiscardDraw(true)}, top: Card{Suit: ORO, Number: 11}, expected: false},
+		{name: "useless_card_without_the_rule", top: Card{Suit: ORO, Number: 11}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameState := New(tt.opts...)
+			playerID := gameState.TurnPlayerID
+			gameState.Players[playerID].Hand.Revealed = append([]Card{}, hand...)
+			gameState.Players[playerID].Melds = tt.melds
+			gameState.DiscardPile = &Pile{Cards: []Card{tt.top}}
+
+			require.Equal(t, tt.expected, NewActionDrawFromDiscardPile(playerID).IsPossible(*gameState))
+			require.True(t, NewActionDrawFromDrawPile(playerID).IsPossible(*gameState))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package chinchon
+
+// WithSubtractiveScoring flips the scoring direction: players start the game with RuleMaxPoints,
+// round winners subtract the points they're awarded, and the first player to reach zero wins.
+func WithSubtractiveScoring(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleIsSubtractiveScoring = enabled
+	}
+}
+
+// initialScore is the score players start the game with, barring starting scores.
+func (g GameState) initialScore() int {
+	if g.RuleIsSubtractiveScoring {
+		return g.RuleMaxPoints
+	}
+	return 0
+}
+
+// winningScore is the score that wins the game.
+func (g GameState) winningScore() int {
+	if g.RuleIsSubtractiveScoring {
+		return 0
+	}
+	return g.RuleMaxPoints
+}
+
+// isValidStartingScore returns true if the score leaves something to play for.
+func (g GameState) isValidStartingScore(score int) bool {
+	if g.RuleIsSubtractiveScoring {
+		return score > 0 && score <= g.RuleMaxPoints
+	}
+	return score >= 0 && score < g.RuleMaxPoints
+}
+
+// hasReachedWinningScore returns true if the player's score wins the game.
+func (g GameState) hasReachedWinningScore(playerID int) bool {
+	if g.RuleIsSubtractiveScoring {
+		return g.Players[playerID].Score <= 0
+	}
+	return g.Players[playerID].Score >= g.RuleMaxPoints
+}
+
+// furthestPastWinningScore returns the player who went furthest past the winning score, in the
+// scoring direction, or -1 if nobody reached it. Ties go to the first of them in PlayerOrder.
+func (g GameState) furthestPastWinningScore() int {
+	winnerID, winnerMargin := -1, 0
+	for _, playerID := range g.PlayerOrder {
+		if !g.hasReachedWinningScore(playerID) {
+			continue
+		}
+		margin := g.Players[playerID].Score - g.winningScore()
+		if g.RuleIsSubtractiveScoring {
+			margin = -margin
+		}
+		if winnerID == -1 || margin > winnerMargin {
+			winnerID, winnerMargin = playerID, margin
+		}
+	}
+	return winnerID
+}
+
+// awardPoints moves the player's score towards the winning score by points.
+func (g *GameState) awardPoints(playerID, points int) {
+	if g.RuleIsSubtractiveScoring {
+		g.Players[playerID].Score -= points
+		return
+	}
+	g.Players[playerID].Score += points
+}
@@ -0,0 +1,53 @@
+package chinchon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubtractiveScoringStartsAtMaxPoints(t *testing.T) {
+	gameState := New(WithSubtractiveScoring(true), WithMaxPoints(50), WithStartingScores(map[int]int{1: 30}))
+
+	require.Equal(t, 50, gameState.Players[0].Score)
+	require.Equal(t, 30, gameState.Players[1].Score)
+	require.Equal(t, 50, gameState.PointsToWin(0))
+}
+
+func TestSubtractiveScoringSubtractsTheRoundWinnersPoints(t *testing.T) {
+	gameState := New(WithSubtractiveScoring(true))
+	winner, loser := gameState.TurnPlayerID, gameState.TurnOpponentPlayerID
+
+	knockWinningRound(t, gameState)
+
+	require.False(t, gameState.IsGameEnded)
+	require.Equal(t, DefaultMaxPoints-60, gameState.Players[winner].Score)
+	require.Equal(t, DefaultMaxPoints, gameState.Players[loser].Score)
+	require.Equal(t, DefaultMaxPoints-60, gameState.PointsToWin(winner))
+}
+
+func TestSubtractiveGameEndsWhenAPlayerReachesZero(t *testing.T) {
+	gameState := New(WithSubtractiveScoring(true), WithStartingScores(map[int]int{0: 50, 1: 50}))
+	winner := gameState.TurnPlayerID
+
+	knockWinningRound(t, gameState)
+
+	require.True(t, gameState.IsGameEnded)
+	require.Equal(t, winner, gameState.WinnerPlayerID)
+	require.Equal(t, 0, gameState.Players[winner].Score)
+	require.Equal(t, 50, gameState.Players[gameState.OpponentOf(winner)].Score)
+}
+
+func TestSubtractiveScoresNeverIncrease(t *testing.T) {
+	for _, seed := range []int64{1, 2, 3} {
+		rng := rand.New(rand.NewSource(seed))
+		gameState := New(WithSubtractiveScoring(true), WithMaxPoints(30))
+		playRandomGame(t, rng, gameState, 5000, func(before map[int]int, action Action) {
+			for playerID, player := range gameState.Players {
+				require.LessOrEqual(t, player.Score, before[playerID], "player %v's score increased after %v", playerID, action)
+				require.GreaterOrEqual(t, player.Score, 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package chinchon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newDrawnTurn returns a game where the turn player has already drawn, holding the given hand and
+// melds.
+func newDrawnTurn(hand []Card, melds []*Meld) *GameState {
+	gameState := New()
+	gameState.Players[gameState.TurnPlayerID].Hand.Revealed = hand
+	gameState.Players[gameState.TurnPlayerID].Melds = melds
+	gameState.HasDrawnThisTurn = true
+	return gameState
+}
+
+func requireActionNames(t *testing.T, gameState *GameState, names ...string) {
+	t.Helper()
+	actual := map[string]bool{}
+	for _, action := range gameState.CalculatePossibleActions() {
+		actual[action.GetName()] = true
+	}
+	for _, name := range names {
+		require.True(t, actual[name], "expected %v to be possible", name)
+	}
+}
+
+func TestDiscardEndsTheTurnWhenThePlayerCannotKnockOrMeld(t *testing.T) {
+	gameState := newDrawnTurn([]Card{
+		{Suit: ORO, Number: 1}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 5}, {Suit: BASTO, Number: 7},
+		{Suit: ORO, Number: 10}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 11}, {Suit: BASTO, Number: 2},
+	}, []*Meld{})
+	playerID := gameState.TurnPlayerID
+
+	require.NoError(t, gameState.RunAction(NewActionDiscardCard(Card{Suit: ORO, Number: 1}, playerID)))
+
+	require.Equal(t, gameState.OpponentOf(playerID), gameState.TurnPlayerID)
+	require.False(t, gameState.HasDrawnThisTurn)
+	require.False(t, gameState.HasDiscardedThisTurn)
+}
+
+func TestDiscardKeepsTheTurnWhenThePlayerCanKnock(t *testing.T) {
+	gameState := newDrawnTurn(
+		[]Card{{Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 2}, {Suit: BASTO, Number: 12}},
+		[]*Meld{
+			{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}}},
+			{Type: MeldTypeSet, Cards: []Card{{Suit: COPA, Number: 4}, {Suit: ESPADA, Number: 4}, {Suit: BASTO, Number: 4}}},
+		},
+	)
+	playerID := gameState.TurnPlayerID
+
+	require.NoError(t, gameState.RunAction(NewActionDiscardCard(Card{Suit: BASTO, Number: 12}, playerID)))
+
+	require.Equal(t, playerID, gameState.TurnPlayerID)
+	require.True(t, gameState.HasDiscardedThisTurn)
+	requireActionNames(t, gameState, KNOCK, END_TURN)
+}
+
+func TestEndTurnPassesTheTurnWithoutKnocking(t *testing.T) {
+	gameState := newDrawnTurn(
+		[]Card{{Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 2}, {Suit: BASTO, Number: 12}},
+		[]*Meld{
+			{Type: MeldTypeRun, Cards: []Card{{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}}},
+			{Type: MeldTypeSet, Cards: []Card{{Suit: COPA, Number: 4}, {Suit: ESPADA, Number: 4}, {Suit: BASTO, Number: 4}}},
+		},
+	)
+	playerID := gameState.TurnPlayerID
+	require.NoError(t, gameState.RunAction(NewActionDiscardCard(Card{Suit: BASTO, Number: 12}, playerID)))
+
+	require.NoError(t, gameState.RunAction(NewActionEndTurn(playerID)))
+
+	require.Equal(t, gameState.OpponentOf(playerID), gameState.TurnPlayerID)
+	require.False(t, gameState.IsRoundFinished)
+	require.Equal(t, -1, gameState.KnockedPlayerID)
+}
+
+func TestEndTurnIsNotPossibleBeforeDiscarding(t *testing.T) {
+	gameState := newDrawnTurn([]Card{{Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 2}}, []*Meld{})
+
+	require.False(t, NewActionEndTurn(gameState.TurnPlayerID).IsPossible(*gameState))
+}
+
+func TestMeldingKeepsTheTurn(t *testing.T) {
+	gameState := newDrawnTurn([]Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3},
+		{Suit: COPA, Number: 7}, {Suit: ESPADA, Number: 10}, {Suit: BASTO, Number: 12},
+	}, []*Meld{})
+	playerID := gameState.TurnPlayerID
+
+	run := []Card{{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}}
+	require.NoError(t, gameState.RunAction(NewActionMeldCards(run, MeldTypeRun, playerID)))
+
+	require.Equal(t, playerID, gameState.TurnPlayerID)
+	requireActionNames(t, gameState, DISCARD_CARD)
+}
+
+// TestRandomGamesFinishRounds asserts that random play reaches the knock after discarding, so rounds
+// can finish at all.
+func TestRandomGamesFinishRounds(t *testing.T) {
+	finishedRounds := 0
+	for seed := int64(1); seed <= 10; seed++ {
+		t.Logf("seed: %v", seed)
+		rng := rand.New(rand.NewSource(seed))
+		gameState := New(WithSeed(seed))
+		playRandomGame(t, rng, gameState, 2000, func(map[int]int, Action) {})
+		finishedRounds += gameState.RoundNumber - 1
+	}
+	require.Greater(t, finishedRounds, 0, "random play should be able to knock and finish rounds")
+}
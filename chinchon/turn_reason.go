@@ -0,0 +1,45 @@
+package chinchon
+
+// Turn reasons explain, from a player's point of view, what the game is waiting for.
+const (
+	TurnReasonCutDeck                = "your turn to cut the deck"
+	TurnReasonDraw                   = "your turn to draw"
+	TurnReasonOpeningDiscardOffer    = "your turn to take or decline the opening discard"
+	TurnReasonDiscard                = "your turn to discard"
+	TurnReasonMeldKnockOrEndTurn     = "your turn to meld, knock or end your turn"
+	TurnReasonConfirmRoundResult     = "confirm round result"
+	TurnReasonWaitingForOpponent     = "waiting for opponent"
+	TurnReasonWaitingForTheirConfirm = "waiting for opponent to confirm round result"
+	TurnReasonConfirmRematch         = "game over, confirm rematch"
+	TurnReasonWaitingForTheirRematch = "game over, waiting for opponent to confirm rematch"
+)
+
+// turnReason returns whether the game is waiting for the player to act, and why.
+//
+// When a round is finished, both players must confirm it regardless of whose turn it is, so a
+// player who hasn't confirmed yet is always expected to act. The same goes for confirming a rematch
+// once the game is ended (see ActionConfirmRematch).
+func (g GameState) turnReason(playerID int) (bool, string) {
+	switch {
+	case g.IsGameEnded && !g.RematchConfirmedPlayerIDs[playerID]:
+		return true, TurnReasonConfirmRematch
+	case g.IsGameEnded:
+		return false, TurnReasonWaitingForTheirRematch
+	case g.IsRoundFinished && !g.RoundFinishedConfirmedPlayerIDs[playerID]:
+		return true, TurnReasonConfirmRoundResult
+	case g.IsRoundFinished:
+		return false, TurnReasonWaitingForTheirConfirm
+	case g.TurnPlayerID != playerID:
+		return false, TurnReasonWaitingForOpponent
+	case g.IsCutPending:
+		return true, TurnReasonCutDeck
+	case g.IsOpeningDiscardOffered:
+		return true, TurnReasonOpeningDiscardOffer
+	case !g.HasDrawnThisTurn:
+		return true, TurnReasonDraw
+	case !g.HasDiscardedThisTurn:
+		return true, TurnReasonDiscard
+	default:
+		return true, TurnReasonMeldKnockOrEndTurn
+	}
+}
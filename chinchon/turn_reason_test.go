@@ -0,0 +1,102 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTurnReason(t *testing.T) {
+	type expectation struct {
+		isYourTurn bool
+		turnReason string
+	}
+	tests := []struct {
+		name  string
+		setUp func(g *GameState)
+		you   expectation
+		them  expectation
+	}{
+		{
+			name:  "start of turn",
+			setUp: func(g *GameState) {},
+			you:   expectation{true, TurnReasonDraw},
+			them:  expectation{false, TurnReasonWaitingForOpponent},
+		},
+		{
+			name:  "after drawing",
+			setUp: func(g *GameState) { g.HasDrawnThisTurn = true },
+			you:   expectation{true, TurnReasonDiscard},
+			them:  expectation{false, TurnReasonWaitingForOpponent},
+		},
+		{
+			name: "after discarding",
+			setUp: func(g *GameState) {
+				g.HasDrawnThisTurn = true
+				g.HasDiscardedThisTurn = true
+			},
+			you:  expectation{true, TurnReasonMeldKnockOrEndTurn},
+			them: expectation{false, TurnReasonWaitingForOpponent},
+		},
+		{
+			name:  "round finished",
+			setUp: func(g *GameState) { g.IsRoundFinished = true },
+			you:   expectation{true, TurnReasonConfirmRoundResult},
+			them:  expectation{true, TurnReasonConfirmRoundResult},
+		},
+		{
+			name: "round finished and confirmed by them",
+			setUp: func(g *GameState) {
+				g.IsRoundFinished = true
+				g.RoundFinishedConfirmedPlayerIDs[g.TurnOpponentPlayerID] = true
+			},
+			you:  expectation{true, TurnReasonConfirmRoundResult},
+			them: expectation{false, TurnReasonWaitingForTheirConfirm},
+		},
+		{
+			name: "game over",
+			setUp: func(g *GameState) {
+				g.IsRoundFinished = true
+				g.IsGameEnded = true
+			},
+			you:  expectation{true, TurnReasonConfirmRematch},
+			them: expectation{true, TurnReasonConfirmRematch},
+		},
+		{
+			name: "game over and rematch confirmed by them",
+			setUp: func(g *GameState) {
+				g.IsRoundFinished = true
+				g.IsGameEnded = true
+				g.RematchConfirmedPlayerIDs = map[int]bool{g.TurnOpponentPlayerID: true}
+			},
+			you:  expectation{true, TurnReasonConfirmRematch},
+			them: expectation{false, TurnReasonWaitingForTheirRematch},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New()
+			you, them := g.TurnPlayerID, g.TurnOpponentPlayerID
+			tt.setUp(g)
+
+			yours := g.ToClientGameState(you)
+			require.Equal(t, tt.you, expectation{yours.IsYourTurn, yours.TurnReason})
+			theirs := g.ToClientGameState(them)
+			require.Equal(t, tt.them, expectation{theirs.IsYourTurn, theirs.TurnReason})
+		})
+	}
+}
+
+func TestIsYourTurnFollowsPlay(t *testing.T) {
+	g := New()
+	you, them := g.TurnPlayerID, g.TurnOpponentPlayerID
+
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(you)))
+	require.True(t, g.ToClientGameState(you).IsYourTurn)
+	discardAndEndTurn(t, g, g.Players[you].Hand.Revealed[0])
+
+	require.False(t, g.ToClientGameState(you).IsYourTurn)
+	require.True(t, g.ToClientGameState(them).IsYourTurn)
+	require.Equal(t, TurnReasonDraw, g.ToClientGameState(them).TurnReason)
+}
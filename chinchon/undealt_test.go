@@ -0,0 +1,80 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// undealtGameState returns a game state as New builds it, but before the first round is dealt:
+// hands and piles are still nil.
+func undealtGameState() *GameState {
+	return &GameState{
+		TurnPlayerID:         0,
+		TurnOpponentPlayerID: 1,
+		Players: map[int]*Player{
+			0: {Hand: nil, Melds: nil, Score: 0},
+			1: {Hand: nil, Melds: nil, Score: 0},
+		},
+		PlayerOrder:        []int{0, 1},
+		WinnerPlayerID:     -1,
+		RoundsLog:          []*RoundLog{{}},
+		KnockedPlayerID:    -1,
+		deck:               newDeck(),
+		RuleMaxPoints:      DefaultMaxPoints,
+		RuleHandSize:       DefaultHandSize,
+		RuleKnockThreshold: DefaultKnockThreshold,
+		RuleGinBonus:       DefaultGinBonus,
+		RuleUndercutBonus:  DefaultUndercutBonus,
+		RuleDeckSize:       DefaultDeckSize,
+	}
+}
+
+func TestActionsOnUndealtStateDoNotPanic(t *testing.T) {
+	card := Card{Suit: ORO, Number: 1}
+	meldCards := []Card{{Suit: ORO, Number: 1}, {Suit: ORO, Number: 2}, {Suit: ORO, Number: 3}}
+
+	for _, playerID := range []int{0, 1} {
+		actions := []Action{
+			NewActionDrawFromDrawPile(playerID),
+			NewActionDrawFromDiscardPile(playerID),
+			NewActionDiscardCard(card, playerID),
+			NewActionMeldCards(meldCards, MeldTypeRun, playerID),
+			NewActionKnock(playerID),
+			NewActionEndTurn(playerID),
+			NewActionConfirmRoundFinished(playerID),
+		}
+		for _, action := range actions {
+			t.Run(action.String(), func(t *testing.T) {
+				gameState := undealtGameState()
+				require.NotPanics(t, func() {
+					require.False(t, action.IsPossible(*gameState))
+					require.Error(t, action.Run(gameState))
+					require.Error(t, gameState.RunAction(action))
+				})
+			})
+		}
+	}
+}
+
+func TestPossibleActionsOnUndealtStateAreEmpty(t *testing.T) {
+	gameState := undealtGameState()
+
+	require.NotPanics(t, func() {
+		require.Empty(t, gameState.CalculatePossibleActions())
+	})
+}
+
+func TestToClientGameStateOnUndealtStateDoesNotPanic(t *testing.T) {
+	gameState := undealtGameState()
+	gameState.RuleIsTrainingMode = true
+
+	for _, playerID := range []int{0, 1} {
+		var cgs ClientGameState
+		require.NotPanics(t, func() { cgs = gameState.ToClientGameState(playerID) })
+		require.Empty(t, cgs.YourHandCards)
+		require.Empty(t, cgs.PossibleActions)
+		require.Equal(t, 0, cgs.YourDeadwoodPoints)
+		require.Equal(t, Card{}, cgs.DiscardPileTopCard)
+	}
+}
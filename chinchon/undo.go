@@ -0,0 +1,150 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errNothingToUndo = errors.New("nothing to undo")
+	errCantUndo      = errors.New("can't undo")
+)
+
+// UndoLastAction takes back the last action of the current round, e.g. for practice, or when a
+// player accepts their opponent's takeback request. It rebuilds the round as it was dealt, and
+// replays all of its actions but the last one. If it fails, the game is left as it was.
+//
+// A discard that was run automatically (see WithAutoDiscardSingleOption) is taken back along with
+// the action that forced it. Knocks can't be taken back, as they finish the round, and neither can
+// the deck cut, which the deal depends on. Games that don't log every action can't undo actions (see
+// WithActionLogging), and neither can unseeded games once the draw pile was reshuffled, as the
+// reshuffle can't be reproduced.
+func (g *GameState) UndoLastAction() error {
+	roundLog := g.RoundsLog[g.RoundNumber]
+	actions := roundLog.ActionsLog
+	first := 0
+	if len(actions) > 0 && actionName(actions[0]) == CUT_DECK {
+		first = 1
+	}
+	switch {
+	case g.IsGameEnded || g.IsRoundFinished:
+		return fmt.Errorf("%w: the round is finished", errNothingToUndo)
+	case g.RuleActionLogging != ActionLoggingAll:
+		return fmt.Errorf("%w: not every action is logged", errCantUndo)
+	case len(actions) <= first:
+		return fmt.Errorf("%w: no actions were played this round", errNothingToUndo)
+	case g.Seed == nil && roundLog.DrawPileReshuffles > 0:
+		return fmt.Errorf("%w: the draw pile was reshuffled in an unseeded game", errCantUndo)
+	}
+
+	for keep := len(actions) - 1; keep >= first; keep-- {
+		undone, err := g.replayRound(actions, first, keep)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errCantUndo, err)
+		}
+		// Replaying an action that forced a discard runs the discard again, so both are taken back.
+		if len(undone.RoundsLog[undone.RoundNumber].ActionsLog) == keep {
+			undone.observers = g.observers
+			*g = *undone
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: the round can't be replayed", errCantUndo) // Unreachable
+}
+
+// replayRound returns a copy of the game with the current round rebuilt as dealt, and the round's
+// actions replayed from first to keep. Actions before first are kept in the log as is.
+func (g *GameState) replayRound(actions []ActionLog, first, keep int) (*GameState, error) {
+	c := g.Clone()
+	c.redealRound(actions[first].PlayerID)
+	c.RoundsLog[c.RoundNumber].ActionsLog = append([]ActionLog{}, actions[:first]...)
+
+	// The referee already reviewed these actions, so it isn't asked again.
+	c.referee = nil
+	for i, actionLog := range actions[first:keep] {
+		if len(c.RoundsLog[c.RoundNumber].ActionsLog) > first+i {
+			continue // Already run automatically
+		}
+		action, err := DeserializeAction(actionLog.Action)
+		if err != nil {
+			return nil, fmt.Errorf("replaying action %v: %w", first+i, err)
+		}
+		if err := c.RunAction(action); err != nil {
+			return nil, fmt.Errorf("replaying action %v: %w", first+i, err)
+		}
+	}
+	c.referee = g.referee
+
+	// Keep the logged actions as they were, e.g. with their referee notes
+	if len(c.RoundsLog[c.RoundNumber].ActionsLog) == keep {
+		c.RoundsLog[c.RoundNumber].ActionsLog = append([]ActionLog{}, actions[:keep]...)
+	}
+	return c, nil
+}
+
+// redealRound resets the current round to how it was dealt, before any action was played other than
+// the deck cut. firstPlayerID is the player who played the round's first action after the deal: the
+// leader, or the player after them if the opening discard was offered.
+func (g *GameState) redealRound(firstPlayerID int) {
+	roundLog := g.RoundsLog[g.RoundNumber]
+	dealt := map[Card]bool{}
+	for _, playerID := range g.PlayerOrder {
+		// Hands are dealt face up, like dealRound does
+		g.Players[playerID].Hand = &Hand{Revealed: cloneCards(roundLog.HandsDealt[playerID].Revealed)}
+		g.Players[playerID].Melds = []*Meld{}
+		for _, card := range g.Players[playerID].Hand.Revealed {
+			dealt[card] = true
+		}
+	}
+	for _, card := range roundLog.InitialDiscardPile {
+		dealt[card] = true
+	}
+
+	// The draw pile is what's left of the deck, in the order it was dealt from
+	g.DrawPile = &Pile{Cards: []Card{}}
+	for _, card := range g.roundDeckOrder {
+		if !dealt[card] {
+			g.DrawPile.AddCard(card)
+		}
+	}
+	g.deck.cards = cloneCards(g.DrawPile.Cards)
+	g.DiscardPile = &Pile{Cards: cloneCards(roundLog.InitialDiscardPile)}
+
+	roundLog.MeldsDealt = map[int][]*Meld{}
+	for _, playerID := range g.PlayerOrder {
+		roundLog.MeldsDealt[playerID] = g.Players[playerID].Melds
+	}
+	roundLog.DrawPileReshuffles = 0
+	roundLog.LastReshuffleActionCount = 0
+	roundLog.DeclinedKnockPlayerIDs = nil
+
+	g.RoundTurnNumber = 1
+	g.RoundActionCount = 0
+	g.KnockedPlayerID = -1
+	g.HasDrawnThisTurn = false
+	g.drewFromDiscardCard = nil
+	g.HasDiscardedThisTurn = false
+	g.IsCutPending = false
+	g.positionCounts = map[uint64]int{}
+
+	g.RoundLeaderPlayerID = firstPlayerID
+	g.TurnPlayerID, g.TurnOpponentPlayerID = firstPlayerID, g.OpponentOf(firstPlayerID)
+	g.IsOpeningDiscardOffered = false
+	if g.offerOpeningDiscard(); g.IsOpeningDiscardOffered {
+		// The offer went to firstPlayerID, so the leader is seated before them.
+		seating := g.seatingFrom(firstPlayerID)
+		g.RoundLeaderPlayerID = seating[len(seating)-1]
+		g.TurnPlayerID, g.TurnOpponentPlayerID = firstPlayerID, g.OpponentOf(firstPlayerID)
+	}
+	g.replenishDrawPile()
+	g.PossibleActions = _serializeActions(g.CalculatePossibleActions())
+}
+
+// actionName returns the name of the logged action, or an empty string if it can't be read.
+func actionName(actionLog ActionLog) string {
+	action, err := DeserializeAction(actionLog.Action)
+	if err != nil {
+		return ""
+	}
+	return action.GetName()
+}
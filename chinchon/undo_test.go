@@ -0,0 +1,150 @@
+package chinchon
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// playWithoutKnocking runs n random actions other than knocking, so that the round goes on.
+func playWithoutKnocking(t *testing.T, rng *rand.Rand, g *GameState, n int) {
+	for i := 0; i < n; i++ {
+		actions := []Action{}
+		for _, action := range g.CalculatePossibleActions() {
+			if action.GetName() != KNOCK && !isForfeit(action) && action.GetPlayerID() == g.TurnPlayerID {
+				actions = append(actions, action)
+			}
+		}
+		require.NoError(t, g.RunAction(actions[rng.Intn(len(actions))]))
+	}
+}
+
+// requireSameState fails unless both games have the same state, hidden information included.
+func requireSameState(t *testing.T, expected, actual *GameState) {
+	expectedJSON, err := expected.Serialize()
+	require.NoError(t, err)
+	actualJSON, err := actual.Serialize()
+	require.NoError(t, err)
+	require.JSONEq(t, string(expectedJSON), string(actualJSON))
+	require.Equal(t, expected.ToClientGameState(0), actual.ToClientGameState(0))
+	require.Equal(t, expected.ToClientGameState(1), actual.ToClientGameState(1))
+}
+
+func TestUndoLastActionRestoresTheEarlierState(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []func(*GameState)
+	}{
+		{name: "seeded", opts: []func(*GameState){WithSeed(7)}},
+		{name: "unseeded"},
+		{name: "opening_discard_offer", opts: []func(*GameState){WithOpeningDiscardRule(OpeningDiscardOfferToBoth)}},
+		{name: "cut_by_opponent", opts: []func(*GameState){WithCut(CutByOpponent)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 10; seed++ {
+				rng := rand.New(rand.NewSource(seed))
+				g := New(tt.opts...)
+				playWithoutKnocking(t, rng, g, 5)
+				snapshot := g.Clone()
+
+				playWithoutKnocking(t, rng, g, 2)
+				require.NoError(t, g.UndoLastAction())
+				require.NoError(t, g.UndoLastAction())
+
+				requireSameState(t, snapshot, g)
+			}
+		})
+	}
+}
+
+func TestUndoLastActionToTheDeal(t *testing.T) {
+	g := New(WithSeed(3))
+	dealt := g.Clone()
+	playWithoutKnocking(t, rand.New(rand.NewSource(3)), g, 3)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, g.UndoLastAction())
+	}
+
+	requireSameState(t, dealt, g)
+	require.ErrorIs(t, g.UndoLastAction(), errNothingToUndo)
+}
+
+func TestUndoLastActionTakesBackForcedDiscards(t *testing.T) {
+	// Player 1 leads the round with three 1s to meld and the 5 de basto to discard.
+	leaderHand := []Card{{Suit: ORO, Number: 1}, {Suit: COPA, Number: 1}, {Suit: ESPADA, Number: 1}, {Suit: BASTO, Number: 5}}
+	opponentHand := []Card{{Suit: ORO, Number: 2}, {Suit: COPA, Number: 3}, {Suit: ESPADA, Number: 4}, {Suit: BASTO, Number: 6}}
+	deckOrder := []Card{}
+	for i := range leaderHand {
+		deckOrder = append(deckOrder, opponentHand[i], leaderHand[i])
+	}
+	for _, card := range spanishCards(DefaultDeckSize) {
+		if !slices.Contains(deckOrder, card) {
+			deckOrder = append(deckOrder, card)
+		}
+	}
+	g, err := NewFromDeck(deckOrder, WithHandSize(4), WithAutoDiscardSingleOption(true))
+	require.NoError(t, err)
+	leader, opponent := g.TurnPlayerID, g.TurnOpponentPlayerID
+	require.Equal(t, 1, leader)
+
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(leader)))
+	require.NoError(t, g.RunAction(NewActionDiscardCard(Card{Suit: BASTO, Number: 5}, leader)))
+	require.NoError(t, g.RunAction(NewActionMeldCards(leaderHand[:3], MeldTypeSet, leader)))
+	require.NoError(t, g.RunAction(NewActionEndTurn(leader)))
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(opponent)))
+	discardAndEndTurn(t, g, opponentHand[0])
+	snapshot := g.Clone()
+
+	// The card taken from the discard pile can't be discarded straight back, so the leader's other
+	// card is discarded automatically.
+	require.NoError(t, g.RunAction(NewActionDrawFromDiscardPile(leader)))
+	require.Len(t, g.RoundsLog[g.RoundNumber].ActionsLog, len(snapshot.RoundsLog[snapshot.RoundNumber].ActionsLog)+2)
+
+	require.NoError(t, g.UndoLastAction())
+
+	requireSameState(t, snapshot, g)
+}
+
+func TestUndoLastActionErrors(t *testing.T) {
+	t.Run("nothing_to_undo", func(t *testing.T) {
+		require.ErrorIs(t, New().UndoLastAction(), errNothingToUndo)
+	})
+
+	t.Run("round_finished", func(t *testing.T) {
+		g := New(WithMaxPoints(1000))
+		knockWinningRound(t, g)
+		require.ErrorIs(t, g.UndoLastAction(), errNothingToUndo)
+	})
+
+	t.Run("deck_cut", func(t *testing.T) {
+		g := New(WithCut(CutByOpponent))
+		require.NoError(t, g.RunAction(NewActionCutDeck(10, g.TurnPlayerID)))
+		require.ErrorIs(t, g.UndoLastAction(), errNothingToUndo)
+	})
+
+	t.Run("not_every_action_logged", func(t *testing.T) {
+		g := New(WithActionLogging(ActionLoggingMeldsAndKnocks))
+		playWithoutKnocking(t, rand.New(rand.NewSource(0)), g, 2)
+		require.ErrorIs(t, g.UndoLastAction(), errCantUndo)
+	})
+
+	t.Run("unseeded_reshuffle", func(t *testing.T) {
+		g := New()
+		playWithoutKnocking(t, rand.New(rand.NewSource(0)), g, 2)
+		g.RoundsLog[g.RoundNumber].DrawPileReshuffles = 1
+		before, err := json.Marshal(g)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, g.UndoLastAction(), errCantUndo)
+
+		after, err := json.Marshal(g)
+		require.NoError(t, err)
+		require.JSONEq(t, string(before), string(after))
+	})
+}
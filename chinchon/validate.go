@@ -0,0 +1,122 @@
+package chinchon
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvariantBroken means the game state is inconsistent, which is always a bug (see Validate).
+var ErrInvariantBroken = errors.New("game invariant broken")
+
+// WithDebugValidation makes RunAction validate the game state after running each action (see
+// GameState.Validate), returning the first broken invariant as an error. It's meant for tests and
+// for debugging bots, e.g. to catch a dealing or scoring bug as soon as it happens.
+func WithDebugValidation(enabled bool) func(*GameState) {
+	return func(gs *GameState) {
+		gs.RuleIsDebugValidation = enabled
+	}
+}
+
+// Validate checks the invariants of the game state, returning an error naming the first one that's
+// broken, or nil if none is:
+//
+//   - Every card of the deck is in exactly one place: a hand, a meld, the draw pile or the discard
+//     pile. This is only checked once the round is dealt.
+//   - The turn player and their opponent are different players.
+//   - The game has a winner if and only if it ended, unless it was aborted for stalling.
+//   - Round finished and rematch confirmations are only from players in the game.
+func (g GameState) Validate() error {
+	if err := g.validateCards(); err != nil {
+		return err
+	}
+	if err := g.validateTurn(); err != nil {
+		return err
+	}
+	if err := g.validateWinner(); err != nil {
+		return err
+	}
+	return g.validateConfirmations()
+}
+
+// validateCards checks that the cards in play are the whole deck, each card appearing once.
+func (g GameState) validateCards() error {
+	if g.RoundNumber == 0 || g.IsCutPending {
+		return nil // Nothing is dealt yet
+	}
+
+	type place struct {
+		name  string
+		cards []Card
+	}
+	places := []place{{"the draw pile", g.DrawPile.cards()}, {"the discard pile", g.DiscardPile.cards()}}
+	for _, playerID := range g.PlayerOrder {
+		player := g.Players[playerID]
+		if player.Hand != nil {
+			hand := append(cloneCards(player.Hand.Revealed), player.Hand.Unrevealed...)
+			places = append(places, place{fmt.Sprintf("player %v's hand", playerID), hand})
+		}
+		for i, meld := range player.Melds {
+			places = append(places, place{fmt.Sprintf("player %v's meld %v", playerID, i), meld.Cards})
+		}
+	}
+
+	remaining := map[Card]bool{}
+	for _, card := range spanishCards(g.RuleDeckSize) {
+		remaining[card] = true
+	}
+	count := 0
+	for _, p := range places {
+		for _, card := range p.cards {
+			if !remaining[card] {
+				return fmt.Errorf("%w: [%v] in %v is repeated or not in the deck", ErrInvariantBroken, card, p.name)
+			}
+			delete(remaining, card)
+			count++
+		}
+	}
+	if len(remaining) > 0 {
+		return fmt.Errorf("%w: %v cards are in play, but the deck has %v", ErrInvariantBroken, count, count+len(remaining))
+	}
+	return nil
+}
+
+// validateTurn checks that it's the turn of a player in the game, facing another one.
+func (g GameState) validateTurn() error {
+	switch {
+	case g.Players[g.TurnPlayerID] == nil:
+		return fmt.Errorf("%w: the turn player %v isn't in the game", ErrInvariantBroken, g.TurnPlayerID)
+	case g.Players[g.TurnOpponentPlayerID] == nil:
+		return fmt.Errorf("%w: the turn opponent %v isn't in the game", ErrInvariantBroken, g.TurnOpponentPlayerID)
+	case g.TurnPlayerID == g.TurnOpponentPlayerID:
+		return fmt.Errorf("%w: player %v is both the turn player and their opponent", ErrInvariantBroken, g.TurnPlayerID)
+	}
+	return nil
+}
+
+// validateWinner checks that the game has a winner if and only if it ended.
+func (g GameState) validateWinner() error {
+	switch {
+	case !g.IsGameEnded && g.WinnerPlayerID != -1:
+		return fmt.Errorf("%w: player %v won a game that hasn't ended", ErrInvariantBroken, g.WinnerPlayerID)
+	case g.IsGameEnded && g.WinnerPlayerID == -1 && !g.IsStallDetected:
+		return fmt.Errorf("%w: the game ended without a winner", ErrInvariantBroken)
+	case g.WinnerPlayerID != -1 && g.Players[g.WinnerPlayerID] == nil:
+		return fmt.Errorf("%w: the winner %v isn't in the game", ErrInvariantBroken, g.WinnerPlayerID)
+	}
+	return nil
+}
+
+// validateConfirmations checks that only players in the game confirmed anything.
+func (g GameState) validateConfirmations() error {
+	for _, playerID := range sortedKeys(g.RoundFinishedConfirmedPlayerIDs) {
+		if g.Players[playerID] == nil {
+			return fmt.Errorf("%w: player %v confirmed the round finished, but isn't in the game", ErrInvariantBroken, playerID)
+		}
+	}
+	for _, playerID := range sortedKeys(g.RematchConfirmedPlayerIDs) {
+		if g.Players[playerID] == nil {
+			return fmt.Errorf("%w: player %v confirmed a rematch, but isn't in the game", ErrInvariantBroken, playerID)
+		}
+	}
+	return nil
+}
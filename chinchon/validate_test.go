@@ -0,0 +1,106 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomGamesKeepTheirInvariants(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []func(*GameState)
+	}{
+		{name: "default"},
+		{name: "three_players", opts: []func(*GameState){WithPlayers(3)}},
+		{name: "four_players", opts: []func(*GameState){WithPlayers(4)}},
+		{name: "48_card_deck", opts: []func(*GameState){WithDeckSize(48)}},
+		{name: "cut_by_opponent", opts: []func(*GameState){WithCut(CutByOpponent)}},
+		{name: "auto_discard", opts: []func(*GameState){WithAutoDiscardSingleOption(true), WithHandSize(4)}},
+		{name: "opening_discard_offer", opts: []func(*GameState){WithOpeningDiscardRule(OpeningDiscardOfferToBoth)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 5; seed++ {
+				g := playSeededGame(t, seed, append([]func(*GameState){WithDebugValidation(true)}, tt.opts...)...)
+				require.True(t, g.IsGameEnded)
+				require.NoError(t, g.Validate())
+			}
+		})
+	}
+}
+
+func TestValidateNamesTheBrokenInvariant(t *testing.T) {
+	tests := []struct {
+		name     string
+		corrupt  func(g *GameState)
+		expected string
+	}{
+		{
+			name:     "repeated_card",
+			corrupt:  func(g *GameState) { g.Players[1].Hand.Revealed[0] = g.Players[1].Hand.Revealed[1] },
+			expected: "in player 1's hand is repeated or not in the deck",
+		},
+		{
+			name:     "card_not_in_the_deck",
+			corrupt:  func(g *GameState) { g.DiscardPile.Cards[0] = Card{Suit: ORO, Number: 8} },
+			expected: "[8 de oro] in the discard pile is repeated or not in the deck",
+		},
+		{
+			name:     "missing_card",
+			corrupt:  func(g *GameState) { _, _ = g.DrawPile.DrawCard() },
+			expected: "39 cards are in play, but the deck has 40",
+		},
+		{
+			name:     "turn_player_is_their_own_opponent",
+			corrupt:  func(g *GameState) { g.TurnOpponentPlayerID = g.TurnPlayerID },
+			expected: "is both the turn player and their opponent",
+		},
+		{
+			name:     "winner_of_an_unfinished_game",
+			corrupt:  func(g *GameState) { g.WinnerPlayerID = 0 },
+			expected: "player 0 won a game that hasn't ended",
+		},
+		{
+			name:     "ended_without_a_winner",
+			corrupt:  func(g *GameState) { g.IsGameEnded = true },
+			expected: "the game ended without a winner",
+		},
+		{
+			name:     "confirmation_from_a_stranger",
+			corrupt:  func(g *GameState) { g.RoundFinishedConfirmedPlayerIDs[2] = true },
+			expected: "player 2 confirmed the round finished, but isn't in the game",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New()
+			require.NoError(t, g.Validate())
+
+			tt.corrupt(g)
+
+			err := g.Validate()
+			require.ErrorIs(t, err, ErrInvariantBroken)
+			require.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
+
+func TestDebugValidationFailsTheActionThatBreaksAnInvariant(t *testing.T) {
+	g := New(WithDebugValidation(true))
+	_, _ = g.DrawPile.DrawCard()
+
+	err := g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID))
+
+	require.ErrorIs(t, err, ErrInvariantBroken)
+	require.ErrorContains(t, err, "after running [")
+}
+
+func TestValidationIsOffByDefault(t *testing.T) {
+	g := New()
+	_, _ = g.DrawPile.DrawCard()
+
+	require.NoError(t, g.RunAction(NewActionDrawFromDrawPile(g.TurnPlayerID)))
+}
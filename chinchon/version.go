@@ -0,0 +1,12 @@
+package chinchon
+
+// version is stamped at build time, e.g.
+//
+//	go build -ldflags "-X github.com/marianogappa/chinchon-backend/chinchon.version=v1.2.3"
+var version = "dev"
+
+// Version returns the engine version the binary was built with, or "dev" if it wasn't stamped.
+// Frontends can compare it with their own to detect a stale, cached engine.
+func Version() string {
+	return version
+}
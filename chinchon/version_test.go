@@ -0,0 +1,15 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionReturnsTheStampedValue(t *testing.T) {
+	defer func(stamped string) { version = stamped }(version)
+	version = "v1.2.3"
+
+	require.Equal(t, "v1.2.3", Version())
+	require.Equal(t, "v1.2.3", New().ToClientGameState(0).EngineVersion)
+}
@@ -0,0 +1,59 @@
+package chinchon
+
+import "math"
+
+// winProbabilitySteepness scales a player's lead, as a fraction of RuleMaxPoints, into a win
+// probability: a lead of half the winning score is worth about 88%.
+const winProbabilitySteepness = 4
+
+// WinProbability returns a heuristic estimate, from 0 to 1, of the player's chances of winning the
+// game, e.g. for a commentary bar. It's not a simulation: it weighs the score difference relative to
+// RuleMaxPoints, plus the difference in best deadwood points in the current round, which counts for
+// more as the draw pile runs out and the round nears its end. Both players' estimates add up to 1.
+func (g GameState) WinProbability(playerID int) float64 {
+	opponentID := g.OpponentOf(playerID)
+	if g.IsGameEnded {
+		switch g.WinnerPlayerID {
+		case playerID:
+			return 1
+		case opponentID:
+			return 0
+		default:
+			return 0.5
+		}
+	}
+
+	return g.winProbability(playerID, g.BestDeadwood(opponentID))
+}
+
+// clientWinProbability returns the player's WinProbability as sent to their client, which can't
+// depend on the opponent's concealed cards: unless hands are open, the opponent's deadwood is
+// estimated from what the player has seen (see estimateOpponentDeadwood).
+func (g GameState) clientWinProbability(playerID int) float64 {
+	if g.IsGameEnded || g.RuleIsOpenHands {
+		return g.WinProbability(playerID)
+	}
+	return g.winProbability(playerID, g.estimateOpponentDeadwood(playerID))
+}
+
+// winProbability returns the player's WinProbability of a game that hasn't ended, given their
+// opponent's deadwood points.
+func (g GameState) winProbability(playerID, opponentDeadwood int) float64 {
+	lead := float64(g.PointsToWin(g.OpponentOf(playerID)) - g.PointsToWin(playerID))
+	if !g.IsRoundFinished {
+		// The round's loser concedes about their deadwood, so the difference is worth that many points.
+		lead += float64(opponentDeadwood-g.BestDeadwood(playerID)) * g.roundProgressWeight()
+	}
+	return 1 / (1 + math.Exp(-winProbabilitySteepness*lead/float64(g.RuleMaxPoints)))
+}
+
+// roundProgressWeight returns how much the round's deadwood counts towards WinProbability: half as
+// the round is dealt, when hands can still change a lot, up to all of it once the draw pile is empty.
+func (g GameState) roundProgressWeight() float64 {
+	initialStock := g.RuleDeckSize - len(g.PlayerOrder)*g.RuleHandSize - g.RuleInitialDiscardCount
+	if initialStock <= 0 {
+		return 1
+	}
+	stockLeft := math.Min(1, float64(g.TurnsUntilStockEmpty())/float64(initialStock))
+	return 1 - stockLeft/2
+}
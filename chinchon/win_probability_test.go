@@ -0,0 +1,75 @@
+package chinchon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// dealEvenHands gives both players hands worth the same deadwood points.
+func dealEvenHands(g *GameState) {
+	g.Players[0].Hand = &Hand{Revealed: []Card{
+		{Suit: ORO, Number: 1}, {Suit: ORO, Number: 3}, {Suit: ORO, Number: 5}, {Suit: ORO, Number: 7},
+		{Suit: COPA, Number: 10}, {Suit: COPA, Number: 12}, {Suit: ESPADA, Number: 2},
+	}}
+	g.Players[1].Hand = &Hand{Revealed: []Card{
+		{Suit: BASTO, Number: 1}, {Suit: BASTO, Number: 3}, {Suit: BASTO, Number: 5}, {Suit: BASTO, Number: 7},
+		{Suit: ESPADA, Number: 10}, {Suit: ESPADA, Number: 12}, {Suit: COPA, Number: 2},
+	}}
+}
+
+func TestWinProbabilityOfATieIsEven(t *testing.T) {
+	gameState := New()
+	dealEvenHands(gameState)
+
+	require.InDelta(t, 0.5, gameState.WinProbability(0), 1e-9)
+	require.InDelta(t, 0.5, gameState.WinProbability(1), 1e-9)
+}
+
+func TestWinProbabilityFavoursTheLeader(t *testing.T) {
+	gameState := New(WithMaxPoints(100))
+	dealEvenHands(gameState)
+	gameState.Players[0].Score = 10
+	gameState.Players[1].Score = 90
+
+	require.Greater(t, gameState.WinProbability(1), 0.9)
+	require.InDelta(t, 1, gameState.WinProbability(0)+gameState.WinProbability(1), 1e-9)
+}
+
+func TestWinProbabilityFavoursLessDeadwood(t *testing.T) {
+	gameState := New()
+	dealEvenHands(gameState)
+	readyToKnock(gameState)
+	early := gameState.WinProbability(gameState.TurnPlayerID)
+	require.Greater(t, early, 0.5)
+
+	// The same deadwood lead counts for more once the draw pile runs out.
+	gameState.DrawPile.Cards = nil
+	require.Greater(t, gameState.WinProbability(gameState.TurnPlayerID), early)
+}
+
+func TestWinProbabilityOfAnEndedGame(t *testing.T) {
+	gameState := New()
+	gameState.IsGameEnded = true
+	gameState.WinnerPlayerID = 1
+
+	require.Equal(t, 0.0, gameState.WinProbability(0))
+	require.Equal(t, 1.0, gameState.WinProbability(1))
+}
+
+func TestWinProbabilityIsOnlySentInTrainingMode(t *testing.T) {
+	require.Nil(t, New().ToClientGameState(0).WinProbability)
+
+	gameState := New(WithTrainingMode(true))
+	winProbability := gameState.ToClientGameState(0).WinProbability
+	require.NotNil(t, winProbability)
+	require.Equal(t, gameState.clientWinProbability(0), *winProbability)
+}
+
+func TestClientWinProbabilityOnlyUsesTheOpponentHandWithOpenHands(t *testing.T) {
+	gameState := New(WithSeed(3))
+	require.Equal(t, gameState.winProbability(0, gameState.estimateOpponentDeadwood(0)), gameState.clientWinProbability(0))
+
+	gameState = New(WithSeed(3), WithOpenHands(true))
+	require.Equal(t, gameState.WinProbability(0), gameState.clientWinProbability(0))
+}
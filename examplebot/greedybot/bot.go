@@ -0,0 +1,150 @@
+// Package greedybot is a simple, deterministic bot that greedily minimises its deadwood. It's meant
+// as a baseline opponent and for testing: like a real client, it only looks at its ClientGameState.
+package greedybot
+
+import (
+	"encoding/json"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// Bot plays greedily, one turn at a time:
+//
+//   - It takes the top of the discard pile only if that lowers its deadwood, and otherwise draws
+//     from the draw pile.
+//   - It discards its highest-value card that isn't part of a meld.
+//   - It lays down the biggest meld it can until there are none left, and then lays off every card
+//     it can.
+//   - It knocks as soon as it can, i.e. as soon as its deadwood is within the knock threshold.
+//
+// It cuts the deck at the middle, and always confirms finished rounds.
+type Bot struct{}
+
+// New returns a greedy bot.
+func New() Bot {
+	return Bot{}
+}
+
+// ChooseAction implements chinchon.Bot.
+func (b Bot) ChooseAction(gs chinchon.ClientGameState) chinchon.Action {
+	actions := possibleActions(gs)
+	if len(actions) == 0 {
+		return nil
+	}
+
+	switch {
+	case len(actions[chinchon.CONFIRM_ROUND_FINISHED]) > 0:
+		return actions[chinchon.CONFIRM_ROUND_FINISHED][0]
+	case len(actions[chinchon.CUT_DECK]) > 0:
+		cuts := actions[chinchon.CUT_DECK]
+		return cuts[len(cuts)/2]
+	case len(actions[chinchon.DRAW_FROM_DISCARD_PILE]) > 0 && lowersDeadwood(gs.YourHandCards, gs.DiscardPileTopCard):
+		return actions[chinchon.DRAW_FROM_DISCARD_PILE][0]
+	case len(actions[chinchon.DRAW_FROM_DRAW_PILE]) > 0:
+		return actions[chinchon.DRAW_FROM_DRAW_PILE][0]
+	case len(actions[chinchon.DECLINE_OPENING_DISCARD]) > 0:
+		return actions[chinchon.DECLINE_OPENING_DISCARD][0]
+	case len(actions[chinchon.DRAW_FROM_DISCARD_PILE]) > 0:
+		// The draw pile is empty.
+		return actions[chinchon.DRAW_FROM_DISCARD_PILE][0]
+	case len(actions[chinchon.DISCARD_CARD]) > 0:
+		return chooseDiscard(gs.YourHandCards, actions[chinchon.DISCARD_CARD])
+	case len(actions[chinchon.MELD_CARDS]) > 0:
+		return biggestMeld(actions[chinchon.MELD_CARDS])
+	case len(actions[chinchon.LAY_OFF_CARD]) > 0:
+		return actions[chinchon.LAY_OFF_CARD][0]
+	case len(actions[chinchon.KNOCK]) > 0:
+		return actions[chinchon.KNOCK][0]
+	case len(actions[chinchon.END_TURN]) > 0:
+		return actions[chinchon.END_TURN][0]
+	}
+	return nil
+}
+
+// possibleActions returns the possible actions by name.
+func possibleActions(gs chinchon.ClientGameState) map[string][]chinchon.Action {
+	actions := map[string][]chinchon.Action{}
+	for _, bs := range gs.PossibleActions {
+		action, err := chinchon.DeserializeAction(json.RawMessage(bs))
+		if err != nil {
+			continue
+		}
+		actions[action.GetName()] = append(actions[action.GetName()], action)
+	}
+	return actions
+}
+
+// lowersDeadwood returns true if taking the card, and then discarding the highest-value card that
+// isn't part of a meld, leaves the hand with less deadwood than it has now.
+func lowersDeadwood(hand []chinchon.Card, card chinchon.Card) bool {
+	_, deadwood := chinchon.OptimalMelds(hand)
+	withCard := append(append([]chinchon.Card{}, hand...), card)
+	discard := highestUnmelded(withCard, hand)
+	_, deadwoodAfter := chinchon.OptimalMelds(without(withCard, discard))
+	return deadwoodAfter < deadwood
+}
+
+// chooseDiscard returns the discard of the highest-value card that isn't part of a meld.
+func chooseDiscard(hand []chinchon.Card, discards []chinchon.Action) chinchon.Action {
+	candidates := make([]chinchon.Card, 0, len(discards))
+	for _, action := range discards {
+		candidates = append(candidates, action.(*chinchon.ActionDiscardCard).Card)
+	}
+	card := highestUnmelded(hand, candidates)
+	for _, action := range discards {
+		if action.(*chinchon.ActionDiscardCard).Card == card {
+			return action
+		}
+	}
+	return discards[0] // Unreachable
+}
+
+// highestUnmelded returns the candidate worth the most deadwood among those that aren't part of the
+// hand's optimal melds, or among all candidates if they're all melded. Ties go to the higher number.
+func highestUnmelded(hand []chinchon.Card, candidates []chinchon.Card) chinchon.Card {
+	melds, _ := chinchon.OptimalMelds(hand)
+	melded := map[chinchon.Card]bool{}
+	for _, meld := range melds {
+		for _, card := range meld.Cards {
+			melded[card] = true
+		}
+	}
+	unmelded := []chinchon.Card{}
+	for _, card := range candidates {
+		if !melded[card] {
+			unmelded = append(unmelded, card)
+		}
+	}
+	if len(unmelded) == 0 {
+		unmelded = candidates
+	}
+
+	best := unmelded[0]
+	for _, card := range unmelded[1:] {
+		if card.DeadwoodValue() > best.DeadwoodValue() || (card.DeadwoodValue() == best.DeadwoodValue() && card.Number > best.Number) {
+			best = card
+		}
+	}
+	return best
+}
+
+// biggestMeld returns the meld with the most cards.
+func biggestMeld(melds []chinchon.Action) chinchon.Action {
+	best := melds[0].(*chinchon.ActionMeldCards)
+	for _, action := range melds[1:] {
+		if meld := action.(*chinchon.ActionMeldCards); len(meld.Cards) > len(best.Cards) {
+			best = meld
+		}
+	}
+	return best
+}
+
+func without(hand []chinchon.Card, card chinchon.Card) []chinchon.Card {
+	result := []chinchon.Card{}
+	for _, c := range hand {
+		if c != card {
+			result = append(result, c)
+		}
+	}
+	return result
+}
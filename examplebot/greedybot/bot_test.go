@@ -0,0 +1,45 @@
+package greedybot
+
+import (
+	"testing"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/marianogappa/chinchon-backend/examplebot/newbot"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGreedyBotPlaysFullGamesAgainstNewbot(t *testing.T) {
+	for seed := int64(0); seed < 5; seed++ {
+		var (
+			g    = chinchon.New(chinchon.WithSeed(seed))
+			bots = map[int]chinchon.Bot{0: New(), 1: newbot.New(newbot.WithSeed(seed))}
+		)
+		for i := 0; !g.IsGameEnded; i++ {
+			require.Less(t, i, 10000, "game didn't end")
+			action := bots[g.TurnPlayerID].ChooseAction(g.ToClientGameState(g.TurnPlayerID))
+			require.NotNil(t, action)
+			require.NoError(t, g.RunAction(action))
+		}
+		require.Contains(t, []int{0, 1}, g.WinnerPlayerID)
+	}
+}
+
+func TestGreedyBotTakesTheDiscardOnlyIfItLowersDeadwood(t *testing.T) {
+	hand := []chinchon.Card{
+		{Suit: chinchon.ORO, Number: 1}, {Suit: chinchon.COPA, Number: 1}, {Suit: chinchon.ESPADA, Number: 1},
+		{Suit: chinchon.ORO, Number: 4}, {Suit: chinchon.ORO, Number: 5}, {Suit: chinchon.BASTO, Number: 12},
+		{Suit: chinchon.COPA, Number: 11},
+	}
+
+	require.True(t, lowersDeadwood(hand, chinchon.Card{Suit: chinchon.ORO, Number: 6}))
+	require.False(t, lowersDeadwood(hand, chinchon.Card{Suit: chinchon.ESPADA, Number: 10}))
+}
+
+func TestGreedyBotDiscardsTheHighestUnmeldedCard(t *testing.T) {
+	hand := []chinchon.Card{
+		{Suit: chinchon.ORO, Number: 10}, {Suit: chinchon.COPA, Number: 10}, {Suit: chinchon.ESPADA, Number: 10},
+		{Suit: chinchon.ORO, Number: 4}, {Suit: chinchon.BASTO, Number: 12}, {Suit: chinchon.COPA, Number: 7},
+	}
+
+	require.Equal(t, chinchon.Card{Suit: chinchon.BASTO, Number: 12}, highestUnmelded(hand, hand))
+}
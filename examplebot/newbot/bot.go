@@ -2,23 +2,78 @@ package newbot
 
 import (
 	"fmt"
+	"math/rand"
 	"os"
+	"time"
 
 	"log"
 
-	"github.com/marianogappa/truco/truco"
+	"github.com/marianogappa/chinchon-backend/chinchon"
 )
 
 type Bot struct {
 	orderedRules []rule
 	st           state
 	logger       Logger
+	rng          *rand.Rand
+
+	// isCautious and discardTolerance configure cautious discards (see WithCautiousDiscards).
+	isCautious       bool
+	discardTolerance int
+
+	// difficulty weakens or changes the bot's play, if set (see WithDifficulty).
+	difficulty Difficulty
 }
 
+// Difficulty is how strongly a bot plays (see WithDifficulty).
+type Difficulty int
+
+const (
+	// DifficultyEasy discards at random and lays down whichever meld it finds first, rather than
+	// the optimal arrangement of its hand.
+	DifficultyEasy Difficulty = iota + 1
+
+	// DifficultyMedium discards well, but greedily lays down the biggest meld it can, and knocks as
+	// soon as its deadwood is within the knock threshold.
+	DifficultyMedium
+
+	// DifficultyHard lays down the optimal arrangement of its hand, and delays knocking while ahead
+	// in the game, waiting for a hand that's unlikely to be undercut.
+	DifficultyHard
+)
+
 func WithDefaultLogger(b *Bot) {
 	b.logger = log.New(os.Stderr, "", log.LstdFlags)
 }
 
+// WithSeed makes the bot's choices deterministic: given identical game states, a bot created with
+// the same seed chooses identical actions. By default, ties between equally good actions are
+// broken at random.
+func WithSeed(seed int64) func(*Bot) {
+	return func(b *Bot) {
+		b.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithCautiousDiscards makes the bot avoid discards that would help the opponent: among discards
+// leaving at most deadwoodTolerance more deadwood than the best one, it picks the one the opponent
+// is least likely to use (see opponentRisk). A zero tolerance never gives up deadwood, only
+// breaking ties between equally good discards; higher tolerances trade deadwood for safety.
+func WithCautiousDiscards(deadwoodTolerance int) func(*Bot) {
+	return func(b *Bot) {
+		b.isCautious = true
+		b.discardTolerance = deadwoodTolerance
+	}
+}
+
+// WithDifficulty sets how strongly the bot plays, e.g. to benchmark clients against varying
+// opponents. By default, bots play like DifficultyHard, except that they knock as soon as they can.
+func WithDifficulty(difficulty Difficulty) func(*Bot) {
+	return func(b *Bot) {
+		b.difficulty = difficulty
+	}
+}
+
 func New(opts ...func(*Bot)) *Bot {
 	// Rules organically form a DAG. Kahn flattens them into a linear order.
 	// If this is not possible (i.e. it's not a DAG), it blows up.
@@ -27,15 +82,22 @@ func New(opts ...func(*Bot)) *Bot {
 		panic(fmt.Errorf("couldn't sort rules: %w; bot is defective! please report this bug!", err))
 	}
 
-	b := &Bot{orderedRules: orderedRules, logger: NoOpLogger{}, st: state{}}
+	b := &Bot{orderedRules: orderedRules, logger: NoOpLogger{}, st: state{}, rng: rand.New(rand.NewSource(time.Now().UnixNano()))}
 	for _, opt := range opts {
 		opt(b)
 	}
+	b.st["rng"] = b.rng
+	if b.isCautious {
+		b.st["discardTolerance"] = b.discardTolerance
+	}
+	if b.difficulty != 0 {
+		b.st["difficulty"] = b.difficulty
+	}
 
 	return b
 }
 
-func (m Bot) ChooseAction(gs truco.ClientGameState) truco.Action {
+func (m Bot) ChooseAction(gs chinchon.ClientGameState) chinchon.Action {
 	// Trivial cases
 	if len(gs.PossibleActions) == 0 {
 		return nil
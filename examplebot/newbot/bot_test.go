@@ -0,0 +1,205 @@
+package newbot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+// playGame plays a full game between two bots under the given rules, returning every state a bot
+// was asked about.
+func playGame(t *testing.T, bots map[int]*Bot, opts ...func(*chinchon.GameState)) []chinchon.ClientGameState {
+	t.Helper()
+	g := chinchon.New(opts...)
+	states := []chinchon.ClientGameState{}
+	for i := 0; !g.IsGameEnded; i++ {
+		require.Less(t, i, 10000, "game didn't end")
+		gs := g.ToClientGameState(g.TurnPlayerID)
+		states = append(states, gs)
+		action := bots[g.TurnPlayerID].ChooseAction(gs)
+		require.NotNil(t, action)
+		require.NoError(t, g.RunAction(action))
+	}
+	return states
+}
+
+func TestBotsPlayAFullGame(t *testing.T) {
+	playGame(t, map[int]*Bot{0: New(), 1: New()})
+}
+
+func TestCautiousBotPlaysAFullGame(t *testing.T) {
+	playGame(t, map[int]*Bot{0: New(WithCautiousDiscards(2)), 1: New()})
+}
+
+func TestBotsPlayAFullGameWithTheOpeningDiscardOffered(t *testing.T) {
+	states := playGame(t, map[int]*Bot{0: New(), 1: New()}, chinchon.WithOpeningDiscardRule(chinchon.OpeningDiscardOfferToBoth))
+	require.True(t, states[0].IsOpeningDiscardOffered)
+}
+
+func TestBotsPlayAFullGameCuttingTheDeck(t *testing.T) {
+	states := playGame(t, map[int]*Bot{0: New(), 1: New()}, chinchon.WithCut(chinchon.CutByOpponent))
+	require.True(t, states[0].IsCutPending)
+}
+
+func TestSeededBotsChooseIdentically(t *testing.T) {
+	states := playGame(t, map[int]*Bot{0: New(WithSeed(42)), 1: New(WithSeed(43))})
+
+	var (
+		a = New(WithSeed(7))
+		b = New(WithSeed(7))
+	)
+	for _, gs := range states {
+		require.Equal(t, a.ChooseAction(gs), b.ChooseAction(gs))
+	}
+}
+
+// discardState is a state where the turn player has drawn and must discard from the given hand,
+// which includes sets of 1s and 2s, while the opponent has melded theirMeld.
+func discardState(t *testing.T, cards []chinchon.Card, theirMeld *chinchon.Meld) chinchon.ClientGameState {
+	g := chinchon.New()
+	playerID := g.TurnPlayerID
+	require.NoError(t, g.RunAction(chinchon.NewActionDrawFromDrawPile(playerID)))
+	g.Players[playerID].Hand.Revealed = append([]chinchon.Card{
+		{Suit: chinchon.ORO, Number: 1}, {Suit: chinchon.COPA, Number: 1}, {Suit: chinchon.ESPADA, Number: 1},
+		{Suit: chinchon.ORO, Number: 2}, {Suit: chinchon.COPA, Number: 2}, {Suit: chinchon.ESPADA, Number: 2},
+	}, cards...)
+	g.Players[g.TurnOpponentPlayerID].Melds = []*chinchon.Meld{theirMeld}
+	g.PossibleActions = nil
+	for _, card := range g.Players[playerID].Hand.Revealed {
+		action, _ := json.Marshal(chinchon.NewActionDiscardCard(card, playerID))
+		g.PossibleActions = append(g.PossibleActions, action)
+	}
+	return g.ToClientGameState(playerID)
+}
+
+func TestCautiousBotAvoidsFeedingTheOpponent(t *testing.T) {
+	var (
+		sixes = &chinchon.Meld{Type: chinchon.MeldTypeSet, Cards: []chinchon.Card{
+			{Suit: chinchon.ORO, Number: 6}, {Suit: chinchon.ESPADA, Number: 6}, {Suit: chinchon.BASTO, Number: 6},
+		}}
+		elevens = &chinchon.Meld{Type: chinchon.MeldTypeSet, Cards: []chinchon.Card{
+			{Suit: chinchon.ORO, Number: 11}, {Suit: chinchon.ESPADA, Number: 11}, {Suit: chinchon.BASTO, Number: 11},
+		}}
+	)
+	tests := []struct {
+		name      string
+		tolerance int
+		cards     []chinchon.Card
+		theirMeld *chinchon.Meld
+		expected  chinchon.Card
+	}{
+		{
+			name:      "equal_deadwood_avoids_extending_their_meld",
+			cards:     []chinchon.Card{{Suit: chinchon.ORO, Number: 10}, {Suit: chinchon.COPA, Number: 11}},
+			theirMeld: elevens,
+			expected:  chinchon.Card{Suit: chinchon.ORO, Number: 10},
+		},
+		{
+			name:      "zero_tolerance_keeps_the_lowest_deadwood",
+			cards:     []chinchon.Card{{Suit: chinchon.BASTO, Number: 5}, {Suit: chinchon.COPA, Number: 6}},
+			theirMeld: sixes,
+			expected:  chinchon.Card{Suit: chinchon.COPA, Number: 6},
+		},
+		{
+			name:      "tolerance_trades_deadwood_for_safety",
+			tolerance: 1,
+			cards:     []chinchon.Card{{Suit: chinchon.BASTO, Number: 5}, {Suit: chinchon.COPA, Number: 6}},
+			theirMeld: sixes,
+			expected:  chinchon.Card{Suit: chinchon.BASTO, Number: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := discardState(t, tt.cards, tt.theirMeld)
+			for seed := int64(0); seed < 10; seed++ {
+				action := New(WithCautiousDiscards(tt.tolerance), WithSeed(seed)).ChooseAction(gs)
+				require.Equal(t, chinchon.NewActionDiscardCard(tt.expected, gs.YouPlayerID), action)
+			}
+		})
+	}
+}
+
+func TestBotsOfEveryDifficultyPlayAFullGame(t *testing.T) {
+	for _, difficulty := range []Difficulty{DifficultyEasy, DifficultyMedium, DifficultyHard} {
+		playGame(t, map[int]*Bot{0: New(WithDifficulty(difficulty)), 1: New(WithDifficulty(difficulty))})
+	}
+}
+
+func TestEasyBotDiscardsAtRandom(t *testing.T) {
+	gs := discardState(t, []chinchon.Card{{Suit: chinchon.ORO, Number: 10}, {Suit: chinchon.COPA, Number: 11}}, &chinchon.Meld{})
+
+	discarded := map[chinchon.Card]bool{}
+	for seed := int64(0); seed < 30; seed++ {
+		action := New(WithDifficulty(DifficultyEasy), WithSeed(seed)).ChooseAction(gs)
+		discarded[action.(*chinchon.ActionDiscardCard).Card] = true
+	}
+	// Discarding any of the 1s or 2s breaks a set, which a better bot never does.
+	require.Greater(t, len(discarded), 2)
+}
+
+// endOfTurnState is a state where the turn player has drawn and discarded, holding the given hand
+// and melds, and may meld, knock or end their turn.
+func endOfTurnState(t *testing.T, hand []chinchon.Card, melds []*chinchon.Meld, yourScore, theirScore int) chinchon.ClientGameState {
+	g := chinchon.New()
+	playerID := g.TurnPlayerID
+	g.Players[playerID].Hand.Revealed = hand
+	g.Players[playerID].Melds = melds
+	g.Players[playerID].Score = yourScore
+	g.Players[g.TurnOpponentPlayerID].Score = theirScore
+	g.HasDrawnThisTurn = true
+	g.HasDiscardedThisTurn = true
+	g.PossibleActions = nil
+	for _, action := range g.CalculatePossibleActions() {
+		bs, err := json.Marshal(action)
+		require.NoError(t, err)
+		g.PossibleActions = append(g.PossibleActions, bs)
+	}
+	return g.ToClientGameState(playerID)
+}
+
+func TestMediumBotMeldsGreedily(t *testing.T) {
+	// The long run leaves the 7s unmelded, whereas the optimal arrangement melds them as a set.
+	hand := []chinchon.Card{
+		{Suit: chinchon.ORO, Number: 4}, {Suit: chinchon.ORO, Number: 5}, {Suit: chinchon.ORO, Number: 6}, {Suit: chinchon.ORO, Number: 7},
+		{Suit: chinchon.COPA, Number: 7}, {Suit: chinchon.BASTO, Number: 7}, {Suit: chinchon.ESPADA, Number: 1},
+	}
+	gs := endOfTurnState(t, hand, []*chinchon.Meld{}, 0, 0)
+
+	medium := New(WithDifficulty(DifficultyMedium)).ChooseAction(gs).(*chinchon.ActionMeldCards)
+	require.Len(t, medium.Cards, 4)
+
+	hard := New(WithDifficulty(DifficultyHard)).ChooseAction(gs).(*chinchon.ActionMeldCards)
+	require.Len(t, hard.Cards, 3)
+}
+
+func TestHardBotDelaysKnockingWhileAhead(t *testing.T) {
+	// Two sets already melded and 7 deadwood points, enough to knock.
+	var (
+		hand  = []chinchon.Card{{Suit: chinchon.BASTO, Number: 7}}
+		melds = []*chinchon.Meld{
+			{Type: chinchon.MeldTypeSet, Cards: []chinchon.Card{{Suit: chinchon.ORO, Number: 1}, {Suit: chinchon.COPA, Number: 1}, {Suit: chinchon.ESPADA, Number: 1}}},
+			{Type: chinchon.MeldTypeSet, Cards: []chinchon.Card{{Suit: chinchon.ORO, Number: 2}, {Suit: chinchon.COPA, Number: 2}, {Suit: chinchon.ESPADA, Number: 2}}},
+		}
+	)
+	tests := []struct {
+		name       string
+		difficulty Difficulty
+		yourScore  int
+		theirScore int
+		expected   string
+	}{
+		{name: "hard_ahead_delays", difficulty: DifficultyHard, yourScore: 50, theirScore: 10, expected: chinchon.END_TURN},
+		{name: "hard_behind_knocks", difficulty: DifficultyHard, yourScore: 10, theirScore: 50, expected: chinchon.KNOCK},
+		{name: "medium_ahead_knocks", difficulty: DifficultyMedium, yourScore: 50, theirScore: 10, expected: chinchon.KNOCK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := endOfTurnState(t, hand, melds, tt.yourScore, tt.theirScore)
+			require.Equal(t, tt.expected, New(WithDifficulty(tt.difficulty)).ChooseAction(gs).GetName())
+		})
+	}
+}
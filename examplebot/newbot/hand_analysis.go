@@ -0,0 +1,110 @@
+package newbot
+
+import "github.com/marianogappa/chinchon-backend/chinchon"
+
+// without returns a copy of the hand without the given card.
+func without(hand []chinchon.Card, card chinchon.Card) []chinchon.Card {
+	result := []chinchon.Card{}
+	for _, c := range hand {
+		if c != card {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// bestDiscards returns the candidate cards whose discard leaves the hand with the lowest optimal
+// deadwood, along with that deadwood.
+func bestDiscards(hand []chinchon.Card, candidates []chinchon.Card) ([]chinchon.Card, int) {
+	var (
+		best         = []chinchon.Card{}
+		bestDeadwood = -1
+	)
+	for _, card := range candidates {
+		_, deadwood := chinchon.OptimalMelds(without(hand, card))
+		switch {
+		case bestDeadwood == -1 || deadwood < bestDeadwood:
+			best = []chinchon.Card{card}
+			bestDeadwood = deadwood
+		case deadwood == bestDeadwood:
+			best = append(best, card)
+		}
+	}
+	return best, bestDeadwood
+}
+
+// isSubset returns true if all cards are contained in the meld.
+func isSubset(cards []chinchon.Card, meld *chinchon.Meld) bool {
+	for _, card := range cards {
+		found := false
+		for _, meldCard := range meld.Cards {
+			if card == meldCard {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// knownUsefulRisk is the opponent risk of a card they're known to be able to meld.
+const knownUsefulRisk = 100
+
+// opponentRisk estimates how useful a discarded card would be to the opponent, the lower the safer.
+//
+// Cards that extend one of their melds, or that form a meld with cards known to be in their hand
+// (e.g. with open hands), are known to be useful. Otherwise, the risk is the number of cards that
+// could form a meld with it and that you haven't seen, since the opponent may be holding them.
+func opponentRisk(gs chinchon.ClientGameState, card chinchon.Card) int {
+	for _, meld := range gs.TheirMelds {
+		extended := &chinchon.Meld{Type: meld.Type, Cards: append(append([]chinchon.Card{}, meld.Cards...), card)}
+		if extended.IsValidWithAceWrap(gs.RuleAceWrap) {
+			return knownUsefulRisk
+		}
+	}
+	if melds, _ := chinchon.OptimalMelds(append(append([]chinchon.Card{}, gs.TheirHandCards...), card)); isInAnyMeld(card, melds) {
+		return knownUsefulRisk
+	}
+
+	seen := map[chinchon.Card]bool{}
+	for _, c := range gs.SeenCards {
+		seen[c] = true
+	}
+	risk := 0
+	for _, partner := range meldPartners(card) {
+		if !seen[partner] {
+			risk++
+		}
+	}
+	return risk
+}
+
+// meldPartners returns the cards that could form a meld with the card: the same number in the
+// other suits, and the adjacent numbers in the same suit.
+func meldPartners(card chinchon.Card) []chinchon.Card {
+	partners := []chinchon.Card{}
+	for _, suit := range []string{chinchon.ORO, chinchon.COPA, chinchon.ESPADA, chinchon.BASTO} {
+		if suit != card.Suit {
+			partners = append(partners, chinchon.Card{Suit: suit, Number: card.Number})
+		}
+	}
+	for _, number := range []int{card.Number - 1, card.Number + 1} {
+		if number >= 1 && number <= 12 {
+			partners = append(partners, chinchon.Card{Suit: card.Suit, Number: number})
+		}
+	}
+	return partners
+}
+
+// isInAnyMeld returns true if the card is part of any of the melds.
+func isInAnyMeld(card chinchon.Card, melds []*chinchon.Meld) bool {
+	for _, meld := range melds {
+		if isSubset([]chinchon.Card{card}, meld) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package newbot
+
+import (
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var (
+	ruleConfirmRoundFinished = rule{
+		name:         "ruleConfirmRoundFinished",
+		description:  "Confirms that the round is finished",
+		isApplicable: ruleConfirmRoundFinishedIsApplicable,
+		dependsOn:    []rule{ruleInitState},
+		run:          ruleConfirmRoundFinishedRun,
+	}
+)
+
+func init() {
+	registerRule(ruleConfirmRoundFinished)
+}
+
+func ruleConfirmRoundFinishedIsApplicable(st state, _ chinchon.ClientGameState) bool {
+	return isPossibleAll(st, chinchon.CONFIRM_ROUND_FINISHED)
+}
+
+func ruleConfirmRoundFinishedRun(st state, _ chinchon.ClientGameState) (ruleResult, error) {
+	return ruleResult{
+		action:            getAction(st, chinchon.CONFIRM_ROUND_FINISHED),
+		stateChanges:      []stateChange{},
+		resultDescription: "Round is finished; confirming.",
+	}, nil
+}
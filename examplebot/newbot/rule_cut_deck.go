@@ -0,0 +1,35 @@
+package newbot
+
+import (
+	"fmt"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var (
+	ruleCutDeck = rule{
+		name:         "ruleCutDeck",
+		description:  "Cuts the deck at a random position",
+		isApplicable: ruleCutDeckIsApplicable,
+		dependsOn:    []rule{ruleInitState},
+		run:          ruleCutDeckRun,
+	}
+)
+
+func init() {
+	registerRule(ruleCutDeck)
+}
+
+func ruleCutDeckIsApplicable(st state, _ chinchon.ClientGameState) bool {
+	return isPossibleAll(st, chinchon.CUT_DECK)
+}
+
+func ruleCutDeckRun(st state, _ chinchon.ClientGameState) (ruleResult, error) {
+	cuts := getActions(st, chinchon.CUT_DECK)
+	cut := cuts[rng(st).Intn(len(cuts))].(*chinchon.ActionCutDeck)
+	return ruleResult{
+		action:            cut,
+		stateChanges:      []stateChange{},
+		resultDescription: fmt.Sprintf("Cutting the deck at %v.", cut.Position),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package newbot
+
+import (
+	"fmt"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var (
+	ruleDiscard = rule{
+		name:         "ruleDiscard",
+		description:  "Decides which card to discard",
+		isApplicable: ruleDiscardIsApplicable,
+		dependsOn:    []rule{ruleDraw},
+		run:          ruleDiscardRun,
+	}
+)
+
+func init() {
+	registerRule(ruleDiscard)
+}
+
+func ruleDiscardIsApplicable(st state, _ chinchon.ClientGameState) bool {
+	return isPossibleAll(st, chinchon.DISCARD_CARD)
+}
+
+func ruleDiscardRun(st state, gs chinchon.ClientGameState) (ruleResult, error) {
+	candidates := []chinchon.Card{}
+	for _, action := range getActions(st, chinchon.DISCARD_CARD) {
+		candidates = append(candidates, action.(*chinchon.ActionDiscardCard).Card)
+	}
+
+	if difficulty(st) == DifficultyEasy {
+		card := candidates[rng(st).Intn(len(candidates))]
+		return ruleResult{
+			action:            chinchon.NewActionDiscardCard(card, gs.YouPlayerID),
+			stateChanges:      []stateChange{},
+			resultDescription: fmt.Sprintf("Discarding %v at random.", card),
+		}, nil
+	}
+
+	if tolerance, ok := st["discardTolerance"].(int); ok {
+		return cautiousDiscard(st, gs, candidates, tolerance), nil
+	}
+
+	// Discard the card that leaves the lowest deadwood, breaking ties at random.
+	best, deadwoodAfter := bestDiscards(gs.YourHandCards, candidates)
+	card := best[rng(st).Intn(len(best))]
+	return ruleResult{
+		action:            chinchon.NewActionDiscardCard(card, gs.YouPlayerID),
+		stateChanges:      []stateChange{},
+		resultDescription: fmt.Sprintf("Discarding %v leaves a deadwood of %v.", card, deadwoodAfter),
+	}, nil
+}
+
+// cautiousDiscard discards the card the opponent is least likely to use, among those leaving at
+// most tolerance more deadwood than the best discard. Ties go to the lowest deadwood, then at random.
+func cautiousDiscard(st state, gs chinchon.ClientGameState, candidates []chinchon.Card, tolerance int) ruleResult {
+	_, bestDeadwood := bestDiscards(gs.YourHandCards, candidates)
+
+	var (
+		safest         = []chinchon.Card{}
+		safestRisk     = -1
+		safestDeadwood = -1
+	)
+	for _, card := range candidates {
+		_, deadwood := chinchon.OptimalMelds(without(gs.YourHandCards, card))
+		if deadwood > bestDeadwood+tolerance {
+			continue
+		}
+		risk := opponentRisk(gs, card)
+		switch {
+		case safestRisk == -1 || risk < safestRisk || (risk == safestRisk && deadwood < safestDeadwood):
+			safest, safestRisk, safestDeadwood = []chinchon.Card{card}, risk, deadwood
+		case risk == safestRisk && deadwood == safestDeadwood:
+			safest = append(safest, card)
+		}
+	}
+
+	card := safest[rng(st).Intn(len(safest))]
+	return ruleResult{
+		action:            chinchon.NewActionDiscardCard(card, gs.YouPlayerID),
+		stateChanges:      []stateChange{},
+		resultDescription: fmt.Sprintf("Discarding %v leaves a deadwood of %v, with an opponent risk of %v.", card, safestDeadwood, safestRisk),
+	}
+}
@@ -0,0 +1,80 @@
+package newbot
+
+import (
+	"fmt"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var (
+	ruleDraw = rule{
+		name:         "ruleDraw",
+		description:  "Decides whether to draw from the draw pile or the discard pile",
+		isApplicable: ruleDrawIsApplicable,
+		dependsOn:    []rule{ruleConfirmRoundFinished},
+		run:          ruleDrawRun,
+	}
+)
+
+func init() {
+	registerRule(ruleDraw)
+}
+
+func ruleDrawIsApplicable(st state, _ chinchon.ClientGameState) bool {
+	return isPossibleAll(st, chinchon.DRAW_FROM_DRAW_PILE) || isPossibleAll(st, chinchon.DRAW_FROM_DISCARD_PILE)
+}
+
+func ruleDrawRun(st state, gs chinchon.ClientGameState) (ruleResult, error) {
+	if isPossibleAll(st, chinchon.DECLINE_OPENING_DISCARD) {
+		return ruleOpeningDiscardOffer(st, gs), nil
+	}
+	if !isPossibleAll(st, chinchon.DRAW_FROM_DISCARD_PILE) {
+		return ruleResult{
+			action:            getAction(st, chinchon.DRAW_FROM_DRAW_PILE),
+			stateChanges:      []stateChange{},
+			resultDescription: "Discard pile is empty; drawing from the draw pile.",
+		}, nil
+	}
+	if !isPossibleAll(st, chinchon.DRAW_FROM_DRAW_PILE) {
+		return ruleResult{
+			action:            getAction(st, chinchon.DRAW_FROM_DISCARD_PILE),
+			stateChanges:      []stateChange{},
+			resultDescription: "Draw pile is empty; drawing from the discard pile.",
+		}, nil
+	}
+
+	// Take the top discard only if, after the best discard of another card, it leaves a lower deadwood.
+	hand := append(append([]chinchon.Card{}, gs.YourHandCards...), gs.DiscardPileTopCard)
+	_, deadwoodAfter := bestDiscards(hand, gs.YourHandCards)
+	if deadwoodAfter < deadwood(st) {
+		return ruleResult{
+			action:            getAction(st, chinchon.DRAW_FROM_DISCARD_PILE),
+			stateChanges:      []stateChange{},
+			resultDescription: fmt.Sprintf("Taking %v lowers deadwood from %v to %v.", gs.DiscardPileTopCard, deadwood(st), deadwoodAfter),
+		}, nil
+	}
+	return ruleResult{
+		action:            getAction(st, chinchon.DRAW_FROM_DRAW_PILE),
+		stateChanges:      []stateChange{},
+		resultDescription: fmt.Sprintf("Taking %v doesn't lower deadwood; drawing from the draw pile.", gs.DiscardPileTopCard),
+	}, nil
+}
+
+// ruleOpeningDiscardOffer takes the opening discard when offered it, if it lowers deadwood just like
+// any other discard would be taken. Otherwise, it declines it.
+func ruleOpeningDiscardOffer(st state, gs chinchon.ClientGameState) ruleResult {
+	hand := append(append([]chinchon.Card{}, gs.YourHandCards...), gs.DiscardPileTopCard)
+	_, deadwoodAfter := bestDiscards(hand, gs.YourHandCards)
+	if deadwoodAfter < deadwood(st) && isPossibleAll(st, chinchon.DRAW_FROM_DISCARD_PILE) {
+		return ruleResult{
+			action:            getAction(st, chinchon.DRAW_FROM_DISCARD_PILE),
+			stateChanges:      []stateChange{},
+			resultDescription: fmt.Sprintf("Taking the opening discard %v lowers deadwood from %v to %v.", gs.DiscardPileTopCard, deadwood(st), deadwoodAfter),
+		}
+	}
+	return ruleResult{
+		action:            getAction(st, chinchon.DECLINE_OPENING_DISCARD),
+		stateChanges:      []stateChange{},
+		resultDescription: fmt.Sprintf("Taking the opening discard %v doesn't lower deadwood; declining it.", gs.DiscardPileTopCard),
+	}
+}
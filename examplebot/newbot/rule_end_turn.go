@@ -0,0 +1,31 @@
+package newbot
+
+import (
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var (
+	ruleEndTurn = rule{
+		name:         "ruleEndTurn",
+		description:  "Ends the turn when there's nothing else worth doing",
+		isApplicable: ruleEndTurnIsApplicable,
+		dependsOn:    []rule{ruleKnock},
+		run:          ruleEndTurnRun,
+	}
+)
+
+func init() {
+	registerRule(ruleEndTurn)
+}
+
+func ruleEndTurnIsApplicable(st state, _ chinchon.ClientGameState) bool {
+	return isPossibleAll(st, chinchon.END_TURN)
+}
+
+func ruleEndTurnRun(st state, _ chinchon.ClientGameState) (ruleResult, error) {
+	return ruleResult{
+		action:            getAction(st, chinchon.END_TURN),
+		stateChanges:      []stateChange{},
+		resultDescription: "Ending turn.",
+	}, nil
+}
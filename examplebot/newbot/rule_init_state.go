@@ -3,7 +3,7 @@ package newbot
 import (
 	"fmt"
 
-	"github.com/marianogappa/truco/truco"
+	"github.com/marianogappa/chinchon-backend/chinchon"
 )
 
 var (
@@ -20,28 +20,18 @@ func init() {
 	registerRule(ruleInitState)
 }
 
-func ruleInitStateIsApplicable(state, truco.ClientGameState) bool {
+func ruleInitStateIsApplicable(state, chinchon.ClientGameState) bool {
 	return true
 }
 
-func ruleInitStateRun(_ state, gs truco.ClientGameState) (ruleResult, error) {
+func ruleInitStateRun(_ state, gs chinchon.ClientGameState) (ruleResult, error) {
 	var (
-		aggresiveness         = calculateAggresiveness(gs)
 		possibleActions       = possibleActionsMap(gs)
 		possibleActionNameSet = possibleActionNameSet(possibleActions)
-		envidoScore           = calculateEnvidoScore(gs)
-		florScore             = calculateFlorScore(gs)
-		faceoffResults        = calculateFaceoffResults(gs)
-		pointsToLose          = calculatePointsToLose(gs)
+		_, deadwood           = chinchon.OptimalMelds(gs.YourHandCards)
 	)
 
 	var (
-		stateChangeAggresiveness = stateChange{
-			fn: func(st *state) {
-				(*st)["aggresiveness"] = aggresiveness
-			},
-			description: fmt.Sprintf("Set aggresiveness to %v", aggresiveness),
-		}
 		statePossibleActions = stateChange{
 			fn: func(st *state) {
 				(*st)["possibleActions"] = possibleActions
@@ -54,104 +44,37 @@ func ruleInitStateRun(_ state, gs truco.ClientGameState) (ruleResult, error) {
 			},
 			description: fmt.Sprintf("Set possibleActionNameSet to %v", possibleActionNameSet),
 		}
-		stateEnvidoScore = stateChange{
-			fn: func(st *state) {
-				(*st)["envidoScore"] = envidoScore
-			},
-			description: fmt.Sprintf("Set envidoScore to %v", envidoScore),
-		}
-		stateFlorScore = stateChange{
-			fn: func(st *state) {
-				(*st)["florScore"] = florScore
-			},
-			description: fmt.Sprintf("Set florScore to %v", florScore),
-		}
-		stateFaceoffResults = stateChange{
-			fn: func(st *state) {
-				(*st)["faceoffResults"] = faceoffResults
-			},
-			description: fmt.Sprintf("Set faceoffResults to %v", faceoffResults),
-		}
-		statePointsToLose = stateChange{
+		stateDeadwood = stateChange{
 			fn: func(st *state) {
-				(*st)["pointsToLose"] = pointsToLose
+				(*st)["deadwood"] = deadwood
 			},
-			description: fmt.Sprintf("Set pointsToLose to %v", pointsToLose),
+			description: fmt.Sprintf("Set deadwood to %v", deadwood),
 		}
 	)
 
 	return ruleResult{
 		action: nil,
 		stateChanges: []stateChange{
-			stateChangeAggresiveness,
 			statePossibleActions,
 			statePossibleActionNameSet,
-			stateEnvidoScore,
-			stateFlorScore,
-			stateFaceoffResults,
-			statePointsToLose,
+			stateDeadwood,
 		},
 		resultDescription: "Initialised bot's state.",
 	}, nil
 }
 
-func calculateAggresiveness(gs truco.ClientGameState) string {
-	aggresiveness := "normal"
-	if gs.YourScore-gs.TheirScore >= 5 {
-		aggresiveness = "low"
-	}
-	if gs.YourScore-gs.TheirScore <= -5 {
-		aggresiveness = "high"
-	}
-	return aggresiveness
-}
-
-func possibleActionsMap(gs truco.ClientGameState) map[string]truco.Action {
-	possibleActions := make(map[string]truco.Action)
+func possibleActionsMap(gs chinchon.ClientGameState) map[string][]chinchon.Action {
+	possibleActions := make(map[string][]chinchon.Action)
 	for _, action := range _deserializeActions(gs.PossibleActions) {
-		possibleActions[action.GetName()] = action
+		possibleActions[action.GetName()] = append(possibleActions[action.GetName()], action)
 	}
 	return possibleActions
 }
 
-func possibleActionNameSet(mp map[string]truco.Action) map[string]struct{} {
+func possibleActionNameSet(mp map[string][]chinchon.Action) map[string]struct{} {
 	possibleActionNames := make(map[string]struct{})
 	for name := range mp {
 		possibleActionNames[name] = struct{}{}
 	}
 	return possibleActionNames
 }
-
-func calculateEnvidoScore(gs truco.ClientGameState) int {
-	return truco.Hand{Revealed: gs.YourRevealedCards, Unrevealed: gs.YourUnrevealedCards}.EnvidoScore()
-}
-
-func calculateFlorScore(gs truco.ClientGameState) int {
-	return truco.Hand{Revealed: gs.YourRevealedCards, Unrevealed: gs.YourUnrevealedCards}.FlorScore()
-}
-
-func calculateFaceoffResults(gs truco.ClientGameState) []int {
-	results := []int{}
-	for i := 0; i < min(len(gs.YourRevealedCards), len(gs.TheirRevealedCards)); i++ {
-		results = append(results, gs.YourRevealedCards[i].CompareTrucoScore(gs.TheirRevealedCards[i]))
-	}
-	return results
-}
-
-const (
-	FACEOFF_WIN  = 1
-	FACEOFF_LOSS = -1
-	FACEOFF_TIE  = 0
-)
-
-func calculatePointsToLose(gs truco.ClientGameState) int {
-	return gs.RuleMaxPoints - gs.TheirScore
-}
-
-func pointsToWin(gs truco.ClientGameState) int {
-	return gs.RuleMaxPoints - gs.YourScore
-}
-
-func youMano(gs truco.ClientGameState) bool {
-	return gs.RoundTurnPlayerID == gs.YouPlayerID
-}
@@ -0,0 +1,56 @@
+package newbot
+
+import (
+	"fmt"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var (
+	ruleKnock = rule{
+		name:         "ruleKnock",
+		description:  "Knocks whenever possible, unless delaying it while ahead",
+		isApplicable: ruleKnockIsApplicable,
+		dependsOn:    []rule{ruleMeld},
+		run:          ruleKnockRun,
+	}
+)
+
+func init() {
+	registerRule(ruleKnock)
+}
+
+func ruleKnockIsApplicable(st state, _ chinchon.ClientGameState) bool {
+	return isPossibleAll(st, chinchon.KNOCK)
+}
+
+// Hard bots ahead in the game only knock with at most hardKnockDeadwood deadwood, unless the draw
+// pile is down to hardKnockStockLeft cards, as they can afford to wait for a hand that's unlikely to
+// be undercut.
+const (
+	hardKnockDeadwood  = 3
+	hardKnockStockLeft = 6
+)
+
+func ruleKnockRun(st state, gs chinchon.ClientGameState) (ruleResult, error) {
+	if difficulty(st) == DifficultyHard && isAhead(gs) && deadwood(st) > hardKnockDeadwood && gs.TurnsUntilStockEmpty > hardKnockStockLeft {
+		return ruleResult{
+			action:            nil,
+			stateChanges:      []stateChange{},
+			resultDescription: fmt.Sprintf("Ahead in the game; delaying the knock with a deadwood of %v.", deadwood(st)),
+		}, nil
+	}
+	return ruleResult{
+		action:            getAction(st, chinchon.KNOCK),
+		stateChanges:      []stateChange{},
+		resultDescription: "Knocking.",
+	}, nil
+}
+
+// isAhead returns true if you're closer to winning the game than your opponent.
+func isAhead(gs chinchon.ClientGameState) bool {
+	if gs.RuleIsSubtractiveScoring {
+		return gs.YourScore < gs.TheirScore
+	}
+	return gs.YourScore > gs.TheirScore
+}
@@ -0,0 +1,82 @@
+package newbot
+
+import (
+	"fmt"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var (
+	ruleMeld = rule{
+		name:         "ruleMeld",
+		description:  "Lays down melds that are part of the optimal arrangement of the hand",
+		isApplicable: ruleMeldIsApplicable,
+		dependsOn:    []rule{ruleDiscard},
+		run:          ruleMeldRun,
+	}
+)
+
+func init() {
+	registerRule(ruleMeld)
+}
+
+func ruleMeldIsApplicable(st state, _ chinchon.ClientGameState) bool {
+	return isPossibleAll(st, chinchon.MELD_CARDS)
+}
+
+func ruleMeldRun(st state, gs chinchon.ClientGameState) (ruleResult, error) {
+	switch difficulty(st) {
+	case DifficultyEasy:
+		meld := getAction(st, chinchon.MELD_CARDS).(*chinchon.ActionMeldCards)
+		return ruleResult{
+			action:            meld,
+			stateChanges:      []stateChange{},
+			resultDescription: fmt.Sprintf("Melding the first meld found, %v as a %v.", meld.Cards, meld.MeldType),
+		}, nil
+	case DifficultyMedium:
+		return greedyMeld(st), nil
+	}
+
+	optimalMelds, _ := chinchon.OptimalMelds(gs.YourHandCards)
+
+	// Pick the biggest possible meld that fits within one of the optimal melds.
+	var best *chinchon.ActionMeldCards
+	for _, action := range getActions(st, chinchon.MELD_CARDS) {
+		meldAction := action.(*chinchon.ActionMeldCards)
+		for _, meld := range optimalMelds {
+			if isSubset(meldAction.Cards, meld) && (best == nil || len(meldAction.Cards) > len(best.Cards)) {
+				best = meldAction
+			}
+		}
+	}
+
+	if best == nil {
+		return ruleResult{
+			action:            nil,
+			stateChanges:      []stateChange{},
+			resultDescription: "No possible meld is part of the optimal arrangement.",
+		}, nil
+	}
+	return ruleResult{
+		action:            best,
+		stateChanges:      []stateChange{},
+		resultDescription: fmt.Sprintf("Melding %v as a %v.", best.Cards, best.MeldType),
+	}, nil
+}
+
+// greedyMeld lays down the biggest possible meld, regardless of whether it's part of the optimal
+// arrangement of the hand.
+func greedyMeld(st state) ruleResult {
+	var best *chinchon.ActionMeldCards
+	for _, action := range getActions(st, chinchon.MELD_CARDS) {
+		meldAction := action.(*chinchon.ActionMeldCards)
+		if best == nil || len(meldAction.Cards) > len(best.Cards) {
+			best = meldAction
+		}
+	}
+	return ruleResult{
+		action:            best,
+		stateChanges:      []stateChange{},
+		resultDescription: fmt.Sprintf("Greedily melding %v as a %v.", best.Cards, best.MeldType),
+	}
+}
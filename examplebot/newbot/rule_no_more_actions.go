@@ -1,7 +1,7 @@
 package newbot
 
 import (
-	"github.com/marianogappa/truco/truco"
+	"github.com/marianogappa/chinchon-backend/chinchon"
 )
 
 var (
@@ -9,7 +9,7 @@ var (
 		name:         "ruleNoMoreActions",
 		description:  "Blows up because no more actions are possible",
 		isApplicable: ruleNoMoreActionsIsApplicable,
-		dependsOn:    []rule{ruleRevealCard},
+		dependsOn:    []rule{ruleEndTurn},
 		run:          ruleNoMoreActionsRun,
 	}
 )
@@ -18,10 +18,10 @@ func init() {
 	registerRule(ruleNoMoreActions)
 }
 
-func ruleNoMoreActionsIsApplicable(st state, _ truco.ClientGameState) bool {
+func ruleNoMoreActionsIsApplicable(st state, _ chinchon.ClientGameState) bool {
 	return true
 }
 
-func ruleNoMoreActionsRun(st state, gs truco.ClientGameState) (ruleResult, error) {
+func ruleNoMoreActionsRun(st state, gs chinchon.ClientGameState) (ruleResult, error) {
 	panic("No more actions are possible.")
 }
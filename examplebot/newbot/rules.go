@@ -3,7 +3,7 @@ package newbot
 import (
 	"errors"
 
-	"github.com/marianogappa/truco/truco"
+	"github.com/marianogappa/chinchon-backend/chinchon"
 )
 
 var (
@@ -27,7 +27,7 @@ type stateChange struct {
 }
 
 type ruleResult struct {
-	action            truco.Action
+	action            chinchon.Action
 	stateChanges      []stateChange
 	resultDescription string
 }
@@ -35,9 +35,9 @@ type ruleResult struct {
 type rule struct {
 	name         string
 	description  string
-	isApplicable func(state, truco.ClientGameState) bool
+	isApplicable func(state, chinchon.ClientGameState) bool
 	dependsOn    []rule
-	run          func(state, truco.ClientGameState) (ruleResult, error)
+	run          func(state, chinchon.ClientGameState) (ruleResult, error)
 }
 
 func topologicalSortKahn(rules []rule) ([]rule, error) {
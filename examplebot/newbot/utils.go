@@ -2,14 +2,15 @@ package newbot
 
 import (
 	"encoding/json"
+	"math/rand"
 
-	"github.com/marianogappa/truco/truco"
+	"github.com/marianogappa/chinchon-backend/chinchon"
 )
 
-func _deserializeActions(as []json.RawMessage) []truco.Action {
-	_as := []truco.Action{}
+func _deserializeActions(as []json.RawMessage) []chinchon.Action {
+	_as := []chinchon.Action{}
 	for _, a := range as {
-		_a, _ := truco.DeserializeAction(a)
+		_a, _ := chinchon.DeserializeAction(a)
 		_as = append(_as, _a)
 	}
 	return _as
@@ -24,31 +25,25 @@ func isPossibleAll(st state, actionNames ...string) bool {
 	return true
 }
 
-// func isPossibleAny(st state, actionNames ...string) bool {
-// 	for _, actionName := range actionNames {
-// 		if _, ok := st["possibleActionNameSet"].(map[string]struct{})[actionName]; ok {
-// 			return true
-// 		}
-// 	}
-// 	return false
-// }
-
-func getAction(st state, actionName string) truco.Action {
-	return st["possibleActions"].(map[string]truco.Action)[actionName]
+// getActions returns all possible actions with the given name, e.g. one discard per card in hand.
+func getActions(st state, actionName string) []chinchon.Action {
+	return st["possibleActions"].(map[string][]chinchon.Action)[actionName]
 }
 
-func aggresiveness(st state) string {
-	return st["aggresiveness"].(string)
+func getAction(st state, actionName string) chinchon.Action {
+	return getActions(st, actionName)[0]
 }
 
-func florScore(st state) int {
-	return st["florScore"].(int)
+func rng(st state) *rand.Rand {
+	return st["rng"].(*rand.Rand)
 }
 
-func envidoScore(st state) int {
-	return st["envidoScore"].(int)
+func deadwood(st state) int {
+	return st["deadwood"].(int)
 }
 
-func pointsToLose(st state) int {
-	return st["pointsToLose"].(int)
+// difficulty returns the bot's difficulty, or zero if it wasn't set (see WithDifficulty).
+func difficulty(st state) Difficulty {
+	d, _ := st["difficulty"].(Difficulty)
+	return d
 }
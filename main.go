@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/marianogappa/chinchon-backend/botclient"
 	"github.com/marianogappa/chinchon-backend/examplebot/newbot"
@@ -18,11 +19,48 @@ func main() {
 	if len(os.Args) < 2 {
 		usage()
 	}
+	var err error
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
 
+	// Bots may play several games, e.g. chinchon bot 1 --games 10.
+	games := 1
+	for i := 2; i < len(os.Args)-1; i++ {
+		if os.Args[i] != "--games" {
+			continue
+		}
+		if games, err = strconv.Atoi(os.Args[i+1]); err != nil || games < 1 {
+			fmt.Println("Invalid number of games. Please provide a positive number.")
+			usage()
+		}
+		os.Args = append(os.Args[:i], os.Args[i+2:]...)
+		break
+	}
+
+	// Replays may set their pace and loop, e.g. chinchon server replay game.json --pace 2s --loop.
+	pace := server.DefaultReplayPace
+	for i := 2; i < len(os.Args)-1; i++ {
+		if os.Args[i] != "--pace" {
+			continue
+		}
+		if pace, err = time.ParseDuration(os.Args[i+1]); err != nil || pace <= 0 {
+			fmt.Println("Invalid pace. Please provide a positive duration, e.g. 1s.")
+			usage()
+		}
+		os.Args = append(os.Args[:i], os.Args[i+2:]...)
+		break
+	}
+	loop := false
+	for i := 2; i < len(os.Args); i++ {
+		if os.Args[i] == "--loop" {
+			loop = true
+			os.Args = append(os.Args[:i], os.Args[i+1:]...)
+			break
+		}
+	}
+
 	cmd := os.Args[1]
 
 	address := fmt.Sprintf("localhost:%v", port)
@@ -30,10 +68,7 @@ func main() {
 		address = os.Args[3]
 	}
 
-	var (
-		playerNum int
-		err       error
-	)
+	var playerNum int
 	if cmd == "player" || cmd == "bot" {
 		playerNum, err = strconv.Atoi(os.Args[2])
 		if err != nil {
@@ -44,11 +79,20 @@ func main() {
 
 	switch cmd {
 	case "server":
-		server.New(port).Start()
+		if len(os.Args) < 4 || os.Args[2] != "replay" {
+			server.New(port).Start()
+			break
+		}
+		replay, err := server.LoadReplayFile(os.Args[3])
+		if err != nil {
+			fmt.Println("Invalid replay file:", err)
+			os.Exit(1)
+		}
+		server.New(port, server.WithReplay(replay), server.WithReplayPace(pace), server.WithReplayLoop(loop)).Start()
 	case "player":
 		exampleclient.Player(playerNum-1, address)
 	case "bot":
-		botclient.Bot(playerNum-1, address, newbot.New(newbot.WithDefaultLogger))
+		botclient.Bot(playerNum-1, address, newbot.New(newbot.WithDefaultLogger), botclient.WithGames(games))
 	default:
 		fmt.Println("Invalid argument. Please provide either server or client.")
 	}
@@ -56,13 +100,16 @@ func main() {
 
 func usage() {
 	fmt.Println("usage: chinchon server")
+	fmt.Println("usage: chinchon server replay %replay [--pace 1s] [--loop]")
 	fmt.Println("usage: chinchon player %number [address]")
-	fmt.Println("usage: chinchon bot %number [address]")
+	fmt.Println("usage: chinchon bot %number [address] [--games %number]")
 	fmt.Println("usage: e.g. chinchon player 1")
 	fmt.Println("usage: e.g. chinchon player 2")
 	fmt.Println("usage: e.g. chinchon player 1 localhost:8080")
 	fmt.Println("usage: chinchon bot 1 localhost:8080")
 	fmt.Println("usage: e.g. chinchon bot 2")
+	fmt.Println("usage: e.g. chinchon bot 2 --games 10 (needs a server with automatic rematches)")
+	fmt.Println("usage: e.g. chinchon server replay game.json --pace 2s --loop (spectators connect to /replay)")
 	fmt.Println("Define the PORT environment variable for chinchon server to change the default port (8080).")
 	os.Exit(1)
 }
@@ -16,6 +16,8 @@ func main() {
 	js.Global().Set("chinchonNew", js.FuncOf(chinchonNew))
 	js.Global().Set("chinchonRunAction", js.FuncOf(chinchonRunAction))
 	js.Global().Set("chinchonBotRunAction", js.FuncOf(chinchonBotRunAction))
+	js.Global().Set("chinchonValidateMeldCards", js.FuncOf(chinchonValidateMeldCards))
+	js.Global().Set("chinchonVersion", js.FuncOf(chinchonVersion))
 	select {}
 }
 
@@ -27,6 +29,18 @@ var (
 type rules struct {
 	MaxPoints     int  `json:"maxPoints"`
 	IsFlorEnabled bool `json:"isFlorEnabled"`
+
+	// KnockThreshold is the maximum deadwood points a player may have in order to knock, e.g. 0 to
+	// only allow knocking with gin. The default if unset.
+	KnockThreshold *int `json:"knockThreshold"`
+
+	// GinBonus and UndercutBonus are the bonuses for going gin and for undercutting a knock. The
+	// defaults if unset.
+	GinBonus      *int `json:"ginBonus"`
+	UndercutBonus *int `json:"undercutBonus"`
+
+	// BotSeed makes the bot's choices reproducible, e.g. to replay a game. Random if unset.
+	BotSeed *int64 `json:"botSeed"`
 }
 
 func chinchonNew(this js.Value, p []js.Value) interface{} {
@@ -40,9 +54,22 @@ func chinchonNew(this js.Value, p []js.Value) interface{} {
 	if r.MaxPoints > 0 {
 		opts = append(opts, chinchon.WithMaxPoints(r.MaxPoints))
 	}
+	if r.KnockThreshold != nil {
+		opts = append(opts, chinchon.WithKnockThreshold(*r.KnockThreshold))
+	}
+	if r.GinBonus != nil {
+		opts = append(opts, chinchon.WithGinBonus(*r.GinBonus))
+	}
+	if r.UndercutBonus != nil {
+		opts = append(opts, chinchon.WithUndercutBonus(*r.UndercutBonus))
+	}
 	state = chinchon.New(opts...)
 
-	bot = (newbot.New())
+	botOpts := []func(*newbot.Bot){}
+	if r.BotSeed != nil {
+		botOpts = append(botOpts, newbot.WithSeed(*r.BotSeed))
+	}
+	bot = newbot.New(botOpts...)
 
 	nbs, err := json.Marshal(state.ToClientGameState(0))
 	if err != nil {
@@ -86,6 +113,32 @@ func chinchonBotRunAction(this js.Value, p []js.Value) interface{} {
 	return buffer
 }
 
+type meldSelection struct {
+	Cards    []chinchon.Card   `json:"cards"`
+	MeldType chinchon.MeldType `json:"meldType"`
+}
+
+// chinchonValidateMeldCards returns why the human player can't meld the selected cards, or an
+// empty string if they can.
+func chinchonValidateMeldCards(this js.Value, p []js.Value) interface{} {
+	jsonBytes := make([]byte, p[0].Length())
+	js.CopyBytesToGo(jsonBytes, p[0])
+	var selection meldSelection
+	if err := json.Unmarshal(jsonBytes, &selection); err != nil {
+		return err.Error()
+	}
+	if err := state.ValidateMeldCards(0, selection.Cards, selection.MeldType); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// chinchonVersion returns the engine version, so that the frontend can detect a stale, cached
+// WASM binary.
+func chinchonVersion(this js.Value, p []js.Value) interface{} {
+	return chinchon.Version()
+}
+
 func _runAction(bs []byte) []byte {
 	action, err := chinchon.DeserializeAction(bs)
 	if err != nil {
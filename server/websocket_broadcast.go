@@ -0,0 +1,72 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// DefaultBroadcastWindow is how long the server waits before sending a player their game state
+// after an action, so that a burst of state changes results in a single message.
+const DefaultBroadcastWindow = 50 * time.Millisecond
+
+// coalescer sends game states to players, coalescing bursts of updates.
+//
+// The first update for a player schedules a send after the window elapses. Updates that arrive
+// in the meantime replace the pending one, so only the latest state is sent. The latest state
+// is always sent eventually.
+type coalescer struct {
+	mu      sync.Mutex
+	sendMu  sync.Mutex
+	window  time.Duration
+	send    func(playerID int, gs chinchon.ClientGameState)
+	pending map[int]chinchon.ClientGameState
+	timers  map[int]*time.Timer
+}
+
+func newCoalescer(window time.Duration, send func(playerID int, gs chinchon.ClientGameState)) *coalescer {
+	return &coalescer{
+		window:  window,
+		send:    send,
+		pending: map[int]chinchon.ClientGameState{},
+		timers:  map[int]*time.Timer{},
+	}
+}
+
+// enqueue schedules gs to be sent to playerID, replacing any state still pending for that player.
+// With a zero window, the state is sent synchronously.
+func (c *coalescer) enqueue(playerID int, gs chinchon.ClientGameState) {
+	if c.window <= 0 {
+		c.send(playerID, gs)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pending[playerID] = gs
+	if _, ok := c.timers[playerID]; ok {
+		return
+	}
+	c.timers[playerID] = time.AfterFunc(c.window, func() { c.flush(playerID) })
+}
+
+func (c *coalescer) flush(playerID int) {
+	// Holding sendMu while picking up the pending state guarantees states go out in order.
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+
+	c.mu.Lock()
+	gs, ok := c.pending[playerID]
+	delete(c.pending, playerID)
+	delete(c.timers, playerID)
+	c.mu.Unlock()
+
+	if ok {
+		c.send(playerID, gs)
+	}
+}
@@ -0,0 +1,90 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSender struct {
+	mu   sync.Mutex
+	sent map[int][]chinchon.ClientGameState
+}
+
+func (r *recordingSender) send(playerID int, gs chinchon.ClientGameState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent[playerID] = append(r.sent[playerID], gs)
+}
+
+func (r *recordingSender) get(playerID int) []chinchon.ClientGameState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]chinchon.ClientGameState(nil), r.sent[playerID]...)
+}
+
+// turnPlayerAction returns the first action the turn player can run.
+func turnPlayerAction(t *testing.T, gameState *chinchon.GameState) chinchon.Action {
+	for _, action := range gameState.CalculatePossibleActions() {
+		if action.GetPlayerID() == gameState.TurnPlayerID {
+			return action
+		}
+	}
+	t.Fatalf("the turn player has no possible actions")
+	return nil
+}
+
+func TestCoalescerSendsOnlyFinalStatePerPlayer(t *testing.T) {
+	rec := &recordingSender{sent: map[int][]chinchon.ClientGameState{}}
+	c := newCoalescer(200*time.Millisecond, rec.send)
+
+	// Simulate a burst of state changes, e.g. an action followed by an automatic turn change.
+	gameState := chinchon.New(chinchon.WithSeed(1))
+	burst := []chinchon.ClientGameState{}
+	for i := 0; i < 4; i++ {
+		require.NoError(t, gameState.RunAction(turnPlayerAction(t, gameState)))
+		burst = append(burst, gameState.ToClientGameState(0))
+		c.enqueue(0, gameState.ToClientGameState(0))
+		c.enqueue(1, gameState.ToClientGameState(1))
+	}
+	for i := 1; i < len(burst); i++ {
+		require.NotEqual(t, burst[i-1], burst[i], "every action in the burst changes the state")
+	}
+
+	require.Eventually(t, func() bool {
+		return len(rec.get(0)) == 1 && len(rec.get(1)) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// No further sends after the window has elapsed.
+	time.Sleep(250 * time.Millisecond)
+	require.Equal(t, []chinchon.ClientGameState{gameState.ToClientGameState(0)}, rec.get(0))
+	require.Equal(t, []chinchon.ClientGameState{gameState.ToClientGameState(1)}, rec.get(1))
+}
+
+func TestCoalescerSendsUpdatesAfterTheWindow(t *testing.T) {
+	rec := &recordingSender{sent: map[int][]chinchon.ClientGameState{}}
+	c := newCoalescer(10*time.Millisecond, rec.send)
+
+	c.enqueue(0, chinchon.ClientGameState{RoundNumber: 1})
+	require.Eventually(t, func() bool { return len(rec.get(0)) == 1 }, time.Second, 5*time.Millisecond)
+
+	c.enqueue(0, chinchon.ClientGameState{RoundNumber: 2})
+	require.Eventually(t, func() bool { return len(rec.get(0)) == 2 }, time.Second, 5*time.Millisecond)
+	require.Equal(t, 2, rec.get(0)[1].RoundNumber)
+}
+
+func TestCoalescerWithZeroWindowSendsImmediately(t *testing.T) {
+	rec := &recordingSender{sent: map[int][]chinchon.ClientGameState{}}
+	c := newCoalescer(0, rec.send)
+
+	c.enqueue(0, chinchon.ClientGameState{RoundNumber: 1})
+	c.enqueue(0, chinchon.ClientGameState{RoundNumber: 2})
+
+	require.Len(t, rec.get(0), 2)
+}
@@ -0,0 +1,83 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"log"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// WithConfirmTimeout makes the server confirm a finished round on behalf of a player who hasn't
+// confirmed it within timeout of their opponent confirming, so that a silent player can't stall the
+// game between rounds. Zero (the default) means waiting for both confirmations indefinitely.
+func WithConfirmTimeout(timeout time.Duration) func(*server) {
+	return func(s *server) {
+		s.confirmTimeout = timeout
+	}
+}
+
+// isHalfConfirmed returns true if the game's round is finished and only one player confirmed it.
+func isHalfConfirmed(gs *chinchon.GameState) bool {
+	return !gs.IsGameEnded && gs.IsRoundFinished && len(gs.RoundFinishedConfirmedPlayerIDs) == 1
+}
+
+// updateConfirmTimeoutLocked starts the confirm timeout once a single player confirmed the finished
+// round, and stops it once the round moves on. gameMu must be held.
+func (g *hostedGame) updateConfirmTimeoutLocked() {
+	if !isHalfConfirmed(g.gameState) {
+		g.stopConfirmTimeoutLocked()
+		return
+	}
+	if g.server.confirmTimeout <= 0 || g.confirmTimer != nil {
+		return
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(g.server.confirmTimeout, func() { g.autoConfirm(timer) })
+	g.confirmTimer = timer
+}
+
+// stopConfirmTimeoutLocked stops the confirm timeout, if running. gameMu must be held.
+func (g *hostedGame) stopConfirmTimeoutLocked() {
+	if g.confirmTimer != nil {
+		g.confirmTimer.Stop()
+		g.confirmTimer = nil
+	}
+}
+
+// autoConfirm confirms the finished round on behalf of the player who hasn't confirmed it, once
+// the confirm timeout expires.
+func (g *hostedGame) autoConfirm(timer *time.Timer) {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	// The timer may have been stopped after it fired, while waiting for gameMu.
+	if g.confirmTimer != timer {
+		return
+	}
+	g.confirmTimer = nil
+	if !isHalfConfirmed(g.gameState) {
+		return
+	}
+
+	// Confirming starts the next round, which clears the confirmations, so pick the silent players first.
+	silentPlayerIDs := []int{}
+	for _, playerID := range g.gameState.PlayerOrder {
+		if !g.gameState.RoundFinishedConfirmedPlayerIDs[playerID] {
+			silentPlayerIDs = append(silentPlayerIDs, playerID)
+		}
+	}
+	for _, playerID := range silentPlayerIDs {
+		log.Printf("Player %v of game %v didn't confirm round %v within %v; confirming it for them\n", playerID, g.id, g.gameState.RoundNumber, g.server.confirmTimeout)
+		if err := g.gameState.RunAction(chinchon.NewActionConfirmRoundFinished(playerID)); err != nil {
+			log.Println("Failed to confirm round:", err)
+			return
+		}
+	}
+	g.broadcastLocked()
+	if g.gameState.IsGameEnded {
+		g.endLocked()
+	}
+}
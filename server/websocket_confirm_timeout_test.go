@@ -0,0 +1,91 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+// finishRound makes the game's turn player knock holding two sets and 7 deadwood points, finishing the round. It returns the
+// knocker.
+func finishRound(t *testing.T, g *hostedGame) int {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	gs := g.gameState
+	knocker := gs.TurnPlayerID
+	gs.Players[knocker].Hand.Revealed = []chinchon.Card{
+		{Suit: chinchon.ORO, Number: 1}, {Suit: chinchon.COPA, Number: 1}, {Suit: chinchon.ESPADA, Number: 1},
+		{Suit: chinchon.ORO, Number: 2}, {Suit: chinchon.COPA, Number: 2}, {Suit: chinchon.ESPADA, Number: 2},
+		{Suit: chinchon.BASTO, Number: 7},
+	}
+	gs.HasDrawnThisTurn = true
+	gs.HasDiscardedThisTurn = true
+	require.NoError(t, gs.RunAction(chinchon.NewActionKnock(knocker)))
+	require.True(t, gs.IsRoundFinished)
+	return knocker
+}
+
+// roundState returns the game's round number, whether the round is finished, and how many players
+// confirmed it.
+func roundState(g *hostedGame) (int, bool, int) {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+	return g.gameState.RoundNumber, g.gameState.IsRoundFinished, len(g.gameState.RoundFinishedConfirmedPlayerIDs)
+}
+
+func roundNumber(g *hostedGame) int {
+	roundNumber, _, _ := roundState(g)
+	return roundNumber
+}
+
+func TestConfirmTimeoutConfirmsForTheSilentPlayer(t *testing.T) {
+	s := New("0", WithConfirmTimeout(10*time.Millisecond))
+	g := defaultGame(s)
+	knocker := finishRound(t, g)
+
+	require.NoError(t, g.runAction(chinchon.NewActionConfirmRoundFinished(knocker)))
+
+	require.Eventually(t, func() bool { return roundNumber(g) == 2 }, time.Second, 5*time.Millisecond)
+	_, isRoundFinished, _ := roundState(g)
+	require.False(t, isRoundFinished)
+}
+
+func TestHalfConfirmedRoundWaitsWithoutConfirmTimeout(t *testing.T) {
+	s := New("0")
+	g := defaultGame(s)
+	knocker := finishRound(t, g)
+
+	require.NoError(t, g.runAction(chinchon.NewActionConfirmRoundFinished(knocker)))
+
+	time.Sleep(50 * time.Millisecond)
+	roundNumber, isRoundFinished, confirmations := roundState(g)
+	require.Equal(t, 1, roundNumber)
+	require.True(t, isRoundFinished)
+	require.Equal(t, 1, confirmations)
+}
+
+func TestConfirmTimeoutStopsOnceBothPlayersConfirm(t *testing.T) {
+	s := New("0", WithConfirmTimeout(20*time.Millisecond))
+	g := defaultGame(s)
+	knocker := finishRound(t, g)
+
+	require.NoError(t, g.runAction(chinchon.NewActionConfirmRoundFinished(knocker)))
+	require.NoError(t, g.runAction(chinchon.NewActionConfirmRoundFinished(1-knocker)))
+	require.Equal(t, 2, roundNumber(g))
+
+	// The next round must be left alone by the stopped timeout.
+	knocker = finishRound(t, g)
+	time.Sleep(50 * time.Millisecond)
+	_, _, confirmations := roundState(g)
+	require.Zero(t, confirmations)
+
+	// A half confirmation in the next round starts a fresh timeout.
+	require.NoError(t, g.runAction(chinchon.NewActionConfirmRoundFinished(knocker)))
+	require.Eventually(t, func() bool { return roundNumber(g) == 3 }, time.Second, 5*time.Millisecond)
+}
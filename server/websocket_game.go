@@ -0,0 +1,185 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// hostedGame is a game hosted by the server, along with its players' connections.
+type hostedGame struct {
+	id        string
+	server    *server
+	gameState *chinchon.GameState
+	players   []*websocket.Conn
+
+	// gameMu guards gameState, which may be replaced by a rematch.
+	gameMu sync.Mutex
+
+	// writeMu serialises writes to player connections, as websocket connections support
+	// a single concurrent writer.
+	writeMu sync.Mutex
+
+	broadcaster *coalescer
+	rematch     *rematchCountdown
+
+	// confirmTimer confirms a finished round for a silent player, if running (see
+	// WithConfirmTimeout).
+	confirmTimer *time.Timer
+
+	// latency delays game states on their way to players, if simulating latency.
+	latency *latencySimulator
+
+	// proposals are the rules proposed by each player, while gameState is nil until they agree.
+	proposals map[int]chinchon.Rules
+}
+
+func newHostedGame(id string, s *server) *hostedGame {
+	g := &hostedGame{
+		id:      id,
+		server:  s,
+		players: []*websocket.Conn{nil, nil},
+	}
+	if s.isRulesNegotiation && s.hostRules == nil {
+		g.proposals = map[int]chinchon.Rules{}
+	} else {
+		g.gameState = s.newGameState(s.hostRules)
+	}
+	if s.maxLatency > 0 {
+		g.latency = newLatencySimulator(s.minLatency, s.maxLatency, g.sendGameState)
+	}
+	g.broadcaster = newCoalescer(s.broadcastWindow, g.deliverGameState)
+	return g
+}
+
+// deliverGameState sends the given game state to a player, after the simulated latency if any.
+func (g *hostedGame) deliverGameState(playerID int, gs chinchon.ClientGameState) {
+	if g.latency != nil {
+		g.latency.enqueue(playerID, gs)
+		return
+	}
+	g.sendGameState(playerID, gs)
+}
+
+// sendGameState sends the given game state to a player, if they are connected.
+func (g *hostedGame) sendGameState(playerID int, gs chinchon.ClientGameState) {
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+
+	conn := g.players[playerID]
+	if conn == nil {
+		return
+	}
+	log.Println("Sending game state to player", playerID, "of game", g.id)
+	msg, _ := NewMessageHeresGameState(gs)
+	if err := WsSend(conn, msg); err != nil {
+		log.Println(err)
+	}
+}
+
+// runAction runs the action on the current game and broadcasts the resulting state to all players.
+func (g *hostedGame) runAction(action chinchon.Action) error {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	if g.gameState == nil {
+		return errRulesNotAgreed
+	}
+	var err error
+	if action.GetName() == chinchon.CONFIRM_REMATCH {
+		err = g.confirmRematchLocked(action)
+	} else {
+		err = g.gameState.RunAction(action)
+	}
+	if err != nil {
+		return err
+	}
+	g.updateConfirmTimeoutLocked()
+	g.broadcastLocked()
+	if g.gameState.IsStallDetected {
+		log.Println("Game", g.id, "was aborted after stalling was detected")
+	}
+	if g.gameState.IsGameEnded {
+		g.endLocked()
+	}
+	return nil
+}
+
+// endLocked handles the end of the game: it either schedules a rematch, or frees the game's slot.
+// gameMu must be held.
+func (g *hostedGame) endLocked() {
+	if g.server.isAutoRematch {
+		g.scheduleRematchLocked()
+		return
+	}
+	g.server.registry.remove(g.id)
+}
+
+// terminate frees the game's slot and disconnects its players, e.g. when the game is abandoned.
+func (g *hostedGame) terminate() {
+	g.server.registry.remove(g.id)
+
+	g.gameMu.Lock()
+	if g.rematch != nil {
+		g.rematch.optedOut = true
+		g.rematch.timer.Stop()
+	}
+	g.stopConfirmTimeoutLocked()
+	g.gameMu.Unlock()
+
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+	for i, conn := range g.players {
+		if conn != nil {
+			conn.Close()
+			g.players[i] = nil
+		}
+	}
+}
+
+// save saves the game to the server's store, if any (see WithGameStore).
+func (g *hostedGame) save() {
+	if g.server.store == nil {
+		return
+	}
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	if g.gameState == nil {
+		return
+	}
+	serialized, err := g.gameState.Serialize()
+	if err == nil {
+		err = g.server.store.SaveGame(g.id, serialized)
+	}
+	if err != nil {
+		log.Println("Failed to save game", g.id+":", err)
+	}
+}
+
+// disconnect frees the player's slot, so that they may connect again.
+func (g *hostedGame) disconnect(playerID int) {
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+	g.players[playerID] = nil
+}
+
+// clientGameState returns the current game state from the point of view of the player.
+func (g *hostedGame) clientGameState(playerID int) chinchon.ClientGameState {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+	return g.gameState.ToClientGameState(playerID)
+}
+
+// broadcastLocked enqueues the current game state for all players. gameMu must be held.
+func (g *hostedGame) broadcastLocked() {
+	for i := range g.players {
+		g.broadcaster.enqueue(i, g.gameState.ToClientGameState(i))
+	}
+}
@@ -0,0 +1,85 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var errActionForAnotherPlayer = errors.New("action for another player")
+
+// WithIllegalActionLogger sets the logger for rejected actions. By default, they are logged as JSON
+// to stderr, so operators can detect clients sending many illegal actions.
+func WithIllegalActionLogger(logger *slog.Logger) func(*server) {
+	return func(s *server) {
+		s.illegalActionLogger = logger
+	}
+}
+
+// WithMaxIllegalActions makes the server disconnect a client once it has sent this many illegal
+// actions, which suggests a buggy or malicious client. Zero means never disconnecting.
+func WithMaxIllegalActions(maxIllegalActions int) func(*server) {
+	return func(s *server) {
+		s.maxIllegalActions = maxIllegalActions
+	}
+}
+
+func defaultIllegalActionLogger() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(os.Stderr, nil))
+}
+
+// illegalActionTracker counts and logs the illegal actions sent through a single connection.
+type illegalActionTracker struct {
+	logger   *slog.Logger
+	max      int
+	gameID   string
+	playerID int
+	count    int
+}
+
+// record logs a rejected action, and returns true if the client should be disconnected.
+func (t *illegalActionTracker) record(action chinchon.Action, err error) bool {
+	t.count++
+	t.logger.Warn("illegal action",
+		slog.String("gameID", t.gameID),
+		slog.Int("playerID", t.playerID),
+		slog.String("action", string(chinchon.SerializeAction(action))),
+		slog.String("reason", illegalActionReason(err)),
+		slog.String("error", err.Error()),
+		slog.Int("illegalActions", t.count),
+	)
+	if t.max <= 0 || t.count < t.max {
+		return false
+	}
+	t.logger.Warn("disconnecting client after too many illegal actions",
+		slog.String("gameID", t.gameID),
+		slog.Int("playerID", t.playerID),
+		slog.Int("illegalActions", t.count),
+	)
+	return true
+}
+
+// illegalActionReason classifies an error returned when running an action, for aggregation.
+func illegalActionReason(err error) string {
+	switch {
+	case errors.Is(err, chinchon.ErrActionNotPossible):
+		return "action_not_possible"
+	case errors.Is(err, chinchon.ErrNotYourTurn):
+		return "not_your_turn"
+	case errors.Is(err, chinchon.ErrGameIsEnded):
+		return "game_is_ended"
+	case errors.Is(err, chinchon.ErrVetoedByReferee):
+		return "vetoed_by_referee"
+	case errors.Is(err, errActionForAnotherPlayer):
+		return "action_for_another_player"
+	case errors.Is(err, errRulesNotAgreed):
+		return "rules_not_agreed"
+	default:
+		return "unknown"
+	}
+}
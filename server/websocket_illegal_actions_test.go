@@ -0,0 +1,101 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer is a bytes.Buffer that is safe to write to from the server's goroutines.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// records returns the logged JSON records with the given message.
+func (b *syncBuffer) records(t *testing.T, msg string) []map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	records := []map[string]any{}
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		if record["msg"] == msg {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func TestIllegalActionTrackerCountsAndLogsAttempts(t *testing.T) {
+	var logs syncBuffer
+	tracker := &illegalActionTracker{logger: slog.New(slog.NewJSONHandler(&logs, nil)), max: 3, gameID: "1", playerID: 0}
+
+	require.False(t, tracker.record(chinchon.NewActionKnock(0), fmt.Errorf("%w trying to run [knock]", chinchon.ErrActionNotPossible)))
+	require.False(t, tracker.record(chinchon.NewActionKnock(0), chinchon.ErrNotYourTurn))
+	require.True(t, tracker.record(chinchon.NewActionKnock(1), errActionForAnotherPlayer))
+	require.Equal(t, 3, tracker.count)
+
+	records := logs.records(t, "illegal action")
+	require.Len(t, records, 3)
+	require.Equal(t, "action_not_possible", records[0]["reason"])
+	require.Equal(t, "not_your_turn", records[1]["reason"])
+	require.Equal(t, "action_for_another_player", records[2]["reason"])
+	require.Equal(t, float64(3), records[2]["illegalActions"])
+	require.Equal(t, "1", records[2]["gameID"])
+	require.Len(t, logs.records(t, "disconnecting client after too many illegal actions"), 1)
+}
+
+func TestIllegalActionTrackerWithoutThresholdNeverDisconnects(t *testing.T) {
+	var logs syncBuffer
+	tracker := &illegalActionTracker{logger: slog.New(slog.NewJSONHandler(&logs, nil))}
+
+	for i := 0; i < 100; i++ {
+		require.False(t, tracker.record(chinchon.NewActionKnock(0), chinchon.ErrNotYourTurn))
+	}
+}
+
+func TestClientExceedingIllegalActionsIsDisconnected(t *testing.T) {
+	var logs syncBuffer
+	s := New("0", WithBroadcastWindow(0), WithMaxIllegalActions(3), WithIllegalActionLogger(slog.New(slog.NewJSONHandler(&logs, nil))))
+	ts := httptest.NewServer(http.HandlerFunc(s.handleWebSocket))
+	defer ts.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, WsSend(conn, NewMessageHello(0)))
+	_, err = WsReadMessage[chinchon.ClientGameState, MessageHeresGameState](conn, MessageTypeHeresGameState)
+	require.NoError(t, err)
+
+	// Knocking before drawing is never possible.
+	for i := 0; i < 3; i++ {
+		msg, _ := NewMessageAction(chinchon.NewActionKnock(0))
+		require.NoError(t, WsSend(conn, msg))
+	}
+
+	_, _, err = conn.ReadMessage()
+	require.Error(t, err, "the server should have closed the connection")
+	require.Len(t, logs.records(t, "illegal action"), 3)
+}
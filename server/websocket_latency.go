@@ -0,0 +1,89 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// WithSimulatedLatency delays every game state sent to players by a random duration between min and
+// max, to simulate real network conditions, e.g. to test a client's loading states and
+// reconnections. Game states still arrive in order, and games are otherwise unaffected. Never
+// enable this in production.
+func WithSimulatedLatency(min, max time.Duration) func(*server) {
+	return func(s *server) {
+		s.minLatency = min
+		s.maxLatency = max
+	}
+}
+
+type delayedGameState struct {
+	gs        chinchon.ClientGameState
+	deliverAt time.Time
+}
+
+// latencySimulator sends game states to players after a random delay, preserving their order.
+//
+// Each state is due after its random delay, but never before the state enqueued before it for the
+// same player, so a short delay may be stretched to keep the order.
+type latencySimulator struct {
+	mu       sync.Mutex
+	sendMu   sync.Mutex
+	min, max time.Duration
+	send     func(playerID int, gs chinchon.ClientGameState)
+	queues   map[int][]delayedGameState
+}
+
+func newLatencySimulator(minDelay, maxDelay time.Duration, send func(playerID int, gs chinchon.ClientGameState)) *latencySimulator {
+	return &latencySimulator{
+		min:    minDelay,
+		max:    max(minDelay, maxDelay),
+		send:   send,
+		queues: map[int][]delayedGameState{},
+	}
+}
+
+// enqueue schedules gs to be sent to playerID after a random delay.
+func (l *latencySimulator) enqueue(playerID int, gs chinchon.ClientGameState) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	deliverAt := time.Now().Add(l.delay())
+	if queue := l.queues[playerID]; len(queue) > 0 && deliverAt.Before(queue[len(queue)-1].deliverAt) {
+		deliverAt = queue[len(queue)-1].deliverAt
+	}
+	l.queues[playerID] = append(l.queues[playerID], delayedGameState{gs: gs, deliverAt: deliverAt})
+	time.AfterFunc(time.Until(deliverAt), func() { l.flush(playerID) })
+}
+
+func (l *latencySimulator) delay() time.Duration {
+	if l.max <= l.min {
+		return l.min
+	}
+	return l.min + time.Duration(rand.Int63n(int64(l.max-l.min)+1))
+}
+
+// flush sends the player's oldest pending state. Timers fire in due order, and each sends exactly
+// one state, so by the time a timer fires its state or an older one is due.
+func (l *latencySimulator) flush(playerID int) {
+	// Holding sendMu while picking up the oldest state guarantees states go out in order.
+	l.sendMu.Lock()
+	defer l.sendMu.Unlock()
+
+	l.mu.Lock()
+	queue := l.queues[playerID]
+	if len(queue) == 0 {
+		l.mu.Unlock()
+		return
+	}
+	delayed := queue[0]
+	l.queues[playerID] = queue[1:]
+	l.mu.Unlock()
+
+	l.send(playerID, delayed.gs)
+}
@@ -0,0 +1,69 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+type timedSender struct {
+	mu      sync.Mutex
+	sent    []chinchon.ClientGameState
+	sentAts []time.Time
+}
+
+func (r *timedSender) send(playerID int, gs chinchon.ClientGameState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent = append(r.sent, gs)
+	r.sentAts = append(r.sentAts, time.Now())
+}
+
+func (r *timedSender) get() ([]chinchon.ClientGameState, []time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]chinchon.ClientGameState(nil), r.sent...), append([]time.Time(nil), r.sentAts...)
+}
+
+func TestSimulatedLatencyDelaysStatesWithinRangeAndInOrder(t *testing.T) {
+	const (
+		minDelay = 20 * time.Millisecond
+		maxDelay = 60 * time.Millisecond
+		states   = 20
+	)
+	rec := &timedSender{}
+	l := newLatencySimulator(minDelay, maxDelay, rec.send)
+
+	start := time.Now()
+	for i := 1; i <= states; i++ {
+		l.enqueue(0, chinchon.ClientGameState{RoundNumber: i})
+	}
+	enqueued := time.Since(start)
+
+	require.Eventually(t, func() bool {
+		sent, _ := rec.get()
+		return len(sent) == states
+	}, time.Second, 5*time.Millisecond)
+
+	sent, sentAts := rec.get()
+	for i, gs := range sent {
+		require.Equal(t, i+1, gs.RoundNumber)
+	}
+	require.GreaterOrEqual(t, sentAts[0].Sub(start), minDelay)
+	// Later states may wait for earlier ones, but never past the longest delay.
+	require.LessOrEqual(t, sentAts[states-1].Sub(start), enqueued+maxDelay+20*time.Millisecond)
+}
+
+func TestSimulatedLatencyAppliesToHostedGames(t *testing.T) {
+	s := New("0", WithSimulatedLatency(10*time.Millisecond, 20*time.Millisecond))
+	require.NotNil(t, defaultGame(s).latency)
+
+	s = New("0")
+	require.Nil(t, defaultGame(s).latency)
+}
@@ -0,0 +1,66 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// LobbyResponse is the lobby's response to game creation and termination requests.
+type LobbyResponse struct {
+	// GameID is the ID of the created game. Players join it via /ws?gameID=<GameID>.
+	GameID string `json:"gameID,omitempty"`
+
+	// Error explains why the request failed, e.g. "server full".
+	Error string `json:"error,omitempty"`
+}
+
+// handleCreateGame creates a new game, or responds 503 if the server is hosting its maximum number
+// of games.
+func (s *server) handleCreateGame(w http.ResponseWriter, r *http.Request) {
+	g, err := s.createGame()
+	if err != nil {
+		log.Println("Refusing to create game:", err)
+		writeLobbyResponse(w, http.StatusServiceUnavailable, LobbyResponse{Error: err.Error()})
+		return
+	}
+	log.Println("Created game", g.id)
+	writeLobbyResponse(w, http.StatusCreated, LobbyResponse{GameID: g.id})
+}
+
+// handleTerminateGame ends a game abruptly, disconnecting its players and freeing its slot. Only
+// requests from the local machine may terminate games, and pinned games, like the default game,
+// can't be terminated.
+func (s *server) handleTerminateGame(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameID"]
+	if !isLocalRequest(r) {
+		log.Println("Refusing to terminate game", gameID, "from", r.RemoteAddr)
+		writeLobbyResponse(w, http.StatusForbidden, LobbyResponse{Error: "forbidden"})
+		return
+	}
+	g, ok := s.registry.get(gameID)
+	if !ok {
+		writeLobbyResponse(w, http.StatusNotFound, LobbyResponse{Error: "game not found"})
+		return
+	}
+	if s.registry.isPinned(gameID) {
+		writeLobbyResponse(w, http.StatusConflict, LobbyResponse{Error: "game is pinned"})
+		return
+	}
+	g.terminate()
+	log.Println("Terminated game", gameID)
+	writeLobbyResponse(w, http.StatusOK, LobbyResponse{GameID: gameID})
+}
+
+func writeLobbyResponse(w http.ResponseWriter, status int, resp LobbyResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Println("Failed to write lobby response:", err)
+	}
+}
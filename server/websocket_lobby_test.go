@@ -0,0 +1,134 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createGame(t *testing.T, s *server) (int, LobbyResponse) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	s.handleCreateGame(w, httptest.NewRequest(http.MethodPost, "/games", nil))
+
+	var resp LobbyResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return w.Code, resp
+}
+
+func TestMaxGamesRejectsGamesPastTheLimit(t *testing.T) {
+	// The default game takes one of the slots.
+	s := New("0", WithMaxGames(3))
+
+	for i := 0; i < 2; i++ {
+		status, resp := createGame(t, s)
+		require.Equal(t, http.StatusCreated, status)
+		require.NotEmpty(t, resp.GameID)
+	}
+
+	status, resp := createGame(t, s)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+	require.Equal(t, "server full", resp.Error)
+	require.Equal(t, 3, s.registry.count())
+}
+
+func TestMaxGamesSlotReopensAfterGameEnds(t *testing.T) {
+	s := New("0", WithMaxGames(2))
+	_, resp := createGame(t, s)
+	status, _ := createGame(t, s)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+
+	g, ok := s.registry.get(resp.GameID)
+	require.True(t, ok)
+	endGame(g)
+
+	status, _ = createGame(t, s)
+	require.Equal(t, http.StatusCreated, status)
+	require.Equal(t, 2, s.registry.count())
+}
+
+func TestMaxGamesSlotReopensAfterGameIsTerminated(t *testing.T) {
+	s := New("0", WithMaxGames(1))
+	status, _ := createGame(t, s)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+
+	g := defaultGame(s)
+	g.terminate()
+	// Ending a terminated game must not free a second slot.
+	endGame(g)
+
+	status, _ = createGame(t, s)
+	require.Equal(t, http.StatusCreated, status)
+	status, _ = createGame(t, s)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+}
+
+func TestPlayersJoinANewDefaultGameAfterItEnds(t *testing.T) {
+	s := New("0", WithBroadcastWindow(0))
+	g := defaultGame(s)
+	endGame(g)
+
+	gs := joinDefaultGame(t, s)
+
+	require.False(t, gs.IsGameEnded)
+	current, ok := s.currentDefaultGame()
+	require.True(t, ok)
+	require.NotEqual(t, g.id, current.id)
+	require.Equal(t, 1, s.registry.count())
+}
+
+func TestPlayersJoinANewDefaultGameAfterItIsTerminated(t *testing.T) {
+	s := New("0", WithBroadcastWindow(0))
+	g := defaultGame(s)
+	g.terminate()
+
+	gs := joinDefaultGame(t, s)
+
+	require.False(t, gs.IsGameEnded)
+	current, ok := s.currentDefaultGame()
+	require.True(t, ok)
+	require.NotEqual(t, g.id, current.id)
+}
+
+func terminateGame(s *server, gameID, remoteAddr string) int {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/games/"+gameID, nil)
+	req.RemoteAddr = remoteAddr
+	s.Handler().ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestTerminateGame(t *testing.T) {
+	tests := []struct {
+		name           string
+		remoteAddr     string
+		pinned         bool
+		expectedStatus int
+	}{
+		{name: "local_request_terminates_the_game", remoteAddr: "127.0.0.1:1234", expectedStatus: http.StatusOK},
+		{name: "remote_request_is_forbidden", remoteAddr: "192.0.2.1:1234", expectedStatus: http.StatusForbidden},
+		{name: "pinned_game_is_not_terminated", remoteAddr: "127.0.0.1:1234", pinned: true, expectedStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New("0")
+			status, resp := createGame(t, s)
+			require.Equal(t, http.StatusCreated, status)
+			if tt.pinned {
+				s.registry.pin(resp.GameID)
+			}
+
+			require.Equal(t, tt.expectedStatus, terminateGame(s, resp.GameID, tt.remoteAddr))
+
+			_, ok := s.registry.get(resp.GameID)
+			require.Equal(t, tt.expectedStatus != http.StatusOK, ok)
+		})
+	}
+}
@@ -14,6 +14,12 @@ const (
 	MessageTypeHeresGameState
 	MessageTypeAction
 	MessageTypeGimmeGameState
+	MessageTypeGimmeAnalysisGameState
+	MessageTypeHeresAnalysisGameState
+	MessageTypeOptOutOfRematch
+	MessageTypeProposeRules
+	MessageTypeHeresRulesProposals
+	MessageTypeRulesRejected
 )
 
 type IWebsocketMessage[T any] interface {
@@ -79,3 +85,76 @@ func NewMessageAction(action chinchon.Action) (MessageAction, error) {
 func (a MessageAction) Deserialize() (chinchon.Action, error) {
 	return chinchon.DeserializeAction(a.Action)
 }
+
+type MessageGimmeAnalysisGameState struct {
+	WebsocketMessage
+}
+
+func NewMessageGimmeAnalysisGameState() MessageGimmeAnalysisGameState {
+	return MessageGimmeAnalysisGameState{WebsocketMessage: WebsocketMessage{Type: MessageTypeGimmeAnalysisGameState}}
+}
+
+type MessageHeresAnalysisGameState struct {
+	WebsocketMessage
+	AnalysisGameState json.RawMessage `json:"analysisGameState"`
+}
+
+func NewMessageHeresAnalysisGameState(analysisGameState chinchon.AnalysisGameState) (MessageHeresAnalysisGameState, error) {
+	bs, err := json.Marshal(analysisGameState)
+	return MessageHeresAnalysisGameState{WebsocketMessage: WebsocketMessage{Type: MessageTypeHeresAnalysisGameState}, AnalysisGameState: bs}, err
+}
+
+func (gs MessageHeresAnalysisGameState) Deserialize() (chinchon.AnalysisGameState, error) {
+	var analysisGameState chinchon.AnalysisGameState
+	err := json.Unmarshal(gs.AnalysisGameState, &analysisGameState)
+	return analysisGameState, err
+}
+
+type MessageOptOutOfRematch struct {
+	WebsocketMessage
+}
+
+func NewMessageOptOutOfRematch() MessageOptOutOfRematch {
+	return MessageOptOutOfRematch{WebsocketMessage: WebsocketMessage{Type: MessageTypeOptOutOfRematch}}
+}
+
+type MessageProposeRules struct {
+	WebsocketMessage
+	Rules chinchon.Rules `json:"rules"`
+}
+
+func NewMessageProposeRules(rules chinchon.Rules) MessageProposeRules {
+	return MessageProposeRules{WebsocketMessage: WebsocketMessage{Type: MessageTypeProposeRules}, Rules: rules}
+}
+
+func (m MessageProposeRules) Deserialize() (chinchon.Rules, error) {
+	return m.Rules, nil
+}
+
+// MessageHeresRulesProposals carries the rules proposed so far by each player, keyed by player ID.
+type MessageHeresRulesProposals struct {
+	WebsocketMessage
+	Proposals map[int]chinchon.Rules `json:"proposals"`
+}
+
+func NewMessageHeresRulesProposals(proposals map[int]chinchon.Rules) MessageHeresRulesProposals {
+	return MessageHeresRulesProposals{WebsocketMessage: WebsocketMessage{Type: MessageTypeHeresRulesProposals}, Proposals: proposals}
+}
+
+func (m MessageHeresRulesProposals) Deserialize() (map[int]chinchon.Rules, error) {
+	return m.Proposals, nil
+}
+
+// MessageRulesRejected tells a player why their rules proposal was rejected.
+type MessageRulesRejected struct {
+	WebsocketMessage
+	Reason string `json:"reason"`
+}
+
+func NewMessageRulesRejected(reason string) MessageRulesRejected {
+	return MessageRulesRejected{WebsocketMessage: WebsocketMessage{Type: MessageTypeRulesRejected}, Reason: reason}
+}
+
+func (m MessageRulesRejected) Deserialize() (string, error) {
+	return m.Reason, nil
+}
@@ -0,0 +1,173 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var errServerFull = errors.New("server full")
+
+// gameRegistry keeps track of the games hosted by the server, enforcing a maximum number of
+// simultaneous games, and when each game was last accessed, so that idle games can be evicted.
+type gameRegistry struct {
+	mu         sync.Mutex
+	maxGames   int
+	games      map[string]*hostedGame
+	lastAccess map[string]time.Time
+	pinned     map[string]bool
+	lastID     int
+
+	// stopJanitor stops the janitor, if running (see startJanitor).
+	stopJanitor     chan struct{}
+	stopJanitorOnce sync.Once
+}
+
+// newGameRegistry creates a registry that hosts at most maxGames games. Zero means no limit.
+func newGameRegistry(maxGames int) *gameRegistry {
+	return &gameRegistry{
+		maxGames:    maxGames,
+		games:       map[string]*hostedGame{},
+		lastAccess:  map[string]time.Time{},
+		pinned:      map[string]bool{},
+		stopJanitor: make(chan struct{}),
+	}
+}
+
+// create registers a new game built by newGame with a fresh ID, or fails with errServerFull if
+// the registry is at capacity.
+func (r *gameRegistry) create(newGame func(id string) *hostedGame) (*hostedGame, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.maxGames > 0 && len(r.games) >= r.maxGames {
+		return nil, errServerFull
+	}
+	r.lastID++
+	id := strconv.Itoa(r.lastID)
+	g := newGame(id)
+	r.games[id] = g
+	r.lastAccess[id] = time.Now()
+	return g, nil
+}
+
+// register registers the game again under its ID, e.g. when its players start a rematch after it
+// freed its slot, or fails with errServerFull if the registry is at capacity. It returns whether the
+// game wasn't registered already.
+func (r *gameRegistry) register(g *hostedGame) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.games[g.id]; ok {
+		return false, nil
+	}
+	if r.maxGames > 0 && len(r.games) >= r.maxGames {
+		return false, errServerFull
+	}
+	r.games[g.id] = g
+	r.lastAccess[g.id] = time.Now()
+	return true, nil
+}
+
+// get returns the game with the given ID, if it's registered, counting as an access.
+func (r *gameRegistry) get(id string) (*hostedGame, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	g, ok := r.games[id]
+	if ok {
+		r.lastAccess[id] = time.Now()
+	}
+	return g, ok
+}
+
+// touch records an access to the game with the given ID, e.g. a message from one of its players.
+func (r *gameRegistry) touch(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.games[id]; ok {
+		r.lastAccess[id] = time.Now()
+	}
+}
+
+// pin keeps the game with the given ID from ever being evicted, e.g. the default game.
+func (r *gameRegistry) pin(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pinned[id] = true
+}
+
+// isPinned returns true if the game with the given ID was pinned.
+func (r *gameRegistry) isPinned(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.pinned[id]
+}
+
+// remove unregisters the game with the given ID, freeing its slot. Removing a game that isn't
+// registered is a no-op, so a game that both ends and gets terminated is only counted once.
+func (r *gameRegistry) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.games, id)
+	delete(r.lastAccess, id)
+	delete(r.pinned, id)
+}
+
+// evictIdle unregisters the games that haven't been accessed for longer than ttl, except pinned
+// ones, returning them.
+func (r *gameRegistry) evictIdle(ttl time.Duration) []*hostedGame {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	evicted := []*hostedGame{}
+	for id, g := range r.games {
+		if r.pinned[id] || time.Since(r.lastAccess[id]) <= ttl {
+			continue
+		}
+		evicted = append(evicted, g)
+		delete(r.games, id)
+		delete(r.lastAccess, id)
+	}
+	return evicted
+}
+
+// startJanitor periodically evicts the games idle for longer than ttl, calling onEvict with each of
+// them, e.g. to disconnect their players, until close is called.
+func (r *gameRegistry) startJanitor(ttl time.Duration, onEvict func(*hostedGame)) {
+	go func() {
+		ticker := time.NewTicker(max(ttl/2, time.Millisecond))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.stopJanitor:
+				return
+			case <-ticker.C:
+				for _, g := range r.evictIdle(ttl) {
+					onEvict(g)
+				}
+			}
+		}
+	}()
+}
+
+// close stops the janitor, if running. Closing more than once is a no-op.
+func (r *gameRegistry) close() {
+	r.stopJanitorOnce.Do(func() { close(r.stopJanitor) })
+}
+
+// count returns the number of registered games.
+func (r *gameRegistry) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.games)
+}
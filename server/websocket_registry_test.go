@@ -0,0 +1,96 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdleGameIsEvictedAfterTTL(t *testing.T) {
+	const ttl = 50 * time.Millisecond
+	s := New("0", WithGameTTL(ttl))
+	t.Cleanup(s.Close)
+	_, idle := createGame(t, s)
+	_, active := createGame(t, s)
+
+	// Keep one game active for a few TTLs.
+	for start := time.Now(); time.Since(start) < 4*ttl; time.Sleep(ttl / 5) {
+		s.registry.touch(active.GameID)
+	}
+
+	require.Equal(t, 2, s.registry.count())
+	_, ok := s.registry.get(active.GameID)
+	require.True(t, ok)
+	_, ok = s.registry.get(s.defaultGameID)
+	require.True(t, ok, "the default game is never evicted")
+	_, ok = s.registry.get(idle.GameID)
+	require.False(t, ok)
+}
+
+func TestEvictedGameSlotIsFreed(t *testing.T) {
+	s := New("0", WithMaxGames(2), WithGameTTL(20*time.Millisecond))
+	t.Cleanup(s.Close)
+	status, _ := createGame(t, s)
+	require.Equal(t, http.StatusCreated, status)
+
+	require.Eventually(t, func() bool { return s.registry.count() == 1 }, time.Second, 5*time.Millisecond)
+	status, _ = createGame(t, s)
+	require.Equal(t, http.StatusCreated, status)
+}
+
+type memoryGameStore struct {
+	mu    sync.Mutex
+	games map[string][]byte
+}
+
+func (s *memoryGameStore) SaveGame(gameID string, serialized []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.games[gameID] = serialized
+	return nil
+}
+
+func (s *memoryGameStore) game(gameID string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	serialized, ok := s.games[gameID]
+	return serialized, ok
+}
+
+func TestEvictedGameIsSavedToTheStore(t *testing.T) {
+	store := &memoryGameStore{games: map[string][]byte{}}
+	s := New("0", WithGameTTL(20*time.Millisecond), WithGameStore(store))
+	t.Cleanup(s.Close)
+	_, idle := createGame(t, s)
+	g, ok := s.registry.get(idle.GameID)
+	require.True(t, ok)
+	gameState := currentGame(g)
+
+	require.Eventually(t, func() bool { _, ok := store.game(idle.GameID); return ok }, time.Second, 5*time.Millisecond)
+
+	serialized, _ := store.game(idle.GameID)
+	restored, err := chinchon.Deserialize(serialized)
+	require.NoError(t, err)
+	require.Equal(t, gameState.Players, restored.Players)
+	_, ok = store.game(s.defaultGameID)
+	require.False(t, ok, "the default game is never evicted")
+}
+
+func TestCloseStopsEvictingGames(t *testing.T) {
+	const ttl = 20 * time.Millisecond
+	s := New("0", WithGameTTL(ttl))
+	s.Close()
+	s.Close()
+	_, idle := createGame(t, s)
+
+	time.Sleep(4 * ttl)
+	_, ok := s.registry.get(idle.GameID)
+	require.True(t, ok)
+}
@@ -0,0 +1,112 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"log"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// DefaultAutoRematchCountdown is how long players have to opt out of an automatic rematch.
+const DefaultAutoRematchCountdown = 10 * time.Second
+
+// WithAutoRematch makes the server start a fresh game with the same players and rules once a game
+// ends, after a countdown during which any player may opt out. Useful for kiosk/demo deployments.
+// The new game is the finished game's rematch (see chinchon.GameState.Rematch), so it keeps the
+// finished game's rules and seating, rather than being created anew by the server. If the players
+// confirm a rematch themselves before the countdown ends (see chinchon.ActionConfirmRematch), the
+// countdown is cancelled.
+func WithAutoRematch(enabled bool) func(*server) {
+	return func(s *server) {
+		s.isAutoRematch = enabled
+	}
+}
+
+// WithAutoRematchCountdown sets how long players have to opt out of an automatic rematch.
+func WithAutoRematchCountdown(countdown time.Duration) func(*server) {
+	return func(s *server) {
+		s.autoRematchCountdown = countdown
+	}
+}
+
+type rematchCountdown struct {
+	timer    *time.Timer
+	optedOut bool
+}
+
+// scheduleRematchLocked starts the rematch countdown, unless one is already running.
+// gameMu must be held.
+func (g *hostedGame) scheduleRematchLocked() {
+	if g.rematch != nil {
+		return
+	}
+	log.Printf("Game %v ended; starting a rematch in %v unless a player opts out\n", g.id, g.server.autoRematchCountdown)
+	countdown := &rematchCountdown{}
+	countdown.timer = time.AfterFunc(g.server.autoRematchCountdown, func() { g.startRematch(countdown) })
+	g.rematch = countdown
+}
+
+// optOutOfRematch cancels the running rematch countdown, if any, freeing the game's slot.
+func (g *hostedGame) optOutOfRematch(playerID int) {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	if g.rematch == nil || g.rematch.optedOut {
+		return
+	}
+	log.Println("Player", playerID, "opted out of the rematch of game", g.id)
+	g.rematch.optedOut = true
+	g.rematch.timer.Stop()
+	g.server.registry.remove(g.id)
+}
+
+func (g *hostedGame) startRematch(countdown *rematchCountdown) {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	if g.rematch != countdown || countdown.optedOut {
+		return
+	}
+	log.Println("Starting rematch of game", g.id)
+	g.gameState = g.gameState.Rematch()
+	g.rematch = nil
+	g.broadcastLocked()
+}
+
+// confirmRematchLocked runs a player's rematch confirmation (see chinchon.ActionConfirmRematch). The
+// last one starts the rematch in the engine, so the game takes a slot again if it had freed it,
+// failing with errServerFull if there's none left, and the rematch countdown is cancelled, as the
+// rematch has already started. gameMu must be held.
+func (g *hostedGame) confirmRematchLocked(action chinchon.Action) error {
+	gs := g.gameState
+	if !action.IsPossible(*gs) || len(gs.RematchConfirmedPlayerIDs) < len(gs.PlayerOrder)-1 {
+		return gs.RunAction(action)
+	}
+
+	registered, err := g.server.registry.register(g)
+	if err != nil {
+		return err
+	}
+	if err := gs.RunAction(action); err != nil {
+		if registered {
+			g.server.registry.remove(g.id)
+		}
+		return err
+	}
+	log.Println("Players of game", g.id, "started a rematch")
+	g.cancelRematchLocked()
+	return nil
+}
+
+// cancelRematchLocked stops the running rematch countdown, if any, without freeing the game's slot.
+// gameMu must be held.
+func (g *hostedGame) cancelRematchLocked() {
+	if g.rematch == nil {
+		return
+	}
+	g.rematch.timer.Stop()
+	g.rematch = nil
+}
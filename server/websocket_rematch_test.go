@@ -0,0 +1,99 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+func defaultGame(s *server) *hostedGame {
+	g, _ := s.registry.get(s.defaultGameID)
+	return g
+}
+
+func endGame(g *hostedGame) *chinchon.GameState {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	g.gameState.IsGameEnded = true
+	g.gameState.WinnerPlayerID = 0
+	g.endLocked()
+	return g.gameState
+}
+
+func currentGame(g *hostedGame) *chinchon.GameState {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+	return g.gameState
+}
+
+func TestAutoRematchStartsANewGame(t *testing.T) {
+	s := New("0", WithAutoRematch(true), WithAutoRematchCountdown(10*time.Millisecond))
+	g := defaultGame(s)
+	endedGame := endGame(g)
+
+	require.Eventually(t, func() bool { return currentGame(g) != endedGame }, time.Second, 5*time.Millisecond)
+	require.False(t, currentGame(g).IsGameEnded)
+}
+
+func TestAutoRematchOptOutPreventsNewGame(t *testing.T) {
+	s := New("0", WithAutoRematch(true), WithAutoRematchCountdown(10*time.Millisecond))
+	g := defaultGame(s)
+	endedGame := endGame(g)
+	g.optOutOfRematch(1)
+
+	time.Sleep(50 * time.Millisecond)
+	require.Same(t, endedGame, currentGame(g))
+	require.True(t, currentGame(g).IsGameEnded)
+	require.Zero(t, s.registry.count(), "opting out should free the game's slot")
+}
+
+func confirmRematch(t *testing.T, g *hostedGame) error {
+	t.Helper()
+	require.NoError(t, g.runAction(chinchon.NewActionConfirmRematch(0)))
+	return g.runAction(chinchon.NewActionConfirmRematch(1))
+}
+
+func TestConfirmedRematchTakesTheGameSlotAgain(t *testing.T) {
+	s := New("0", WithMaxGames(1))
+	g := defaultGame(s)
+	endGame(g)
+	require.Zero(t, s.registry.count())
+
+	require.NoError(t, confirmRematch(t, g))
+
+	require.False(t, currentGame(g).IsGameEnded)
+	require.Equal(t, 1, s.registry.count(), "the rematch should count against the max games")
+}
+
+func TestConfirmedRematchIsRejectedWhenTheServerIsFull(t *testing.T) {
+	s := New("0", WithMaxGames(1))
+	g := defaultGame(s)
+	endGame(g)
+	_, err := s.createGame()
+	require.NoError(t, err)
+
+	require.ErrorIs(t, confirmRematch(t, g), errServerFull)
+
+	require.True(t, currentGame(g).IsGameEnded)
+	require.Equal(t, 1, s.registry.count())
+}
+
+func TestConfirmedRematchCancelsTheAutoRematch(t *testing.T) {
+	s := New("0", WithAutoRematch(true), WithAutoRematchCountdown(20*time.Millisecond))
+	g := defaultGame(s)
+	endGame(g)
+
+	require.NoError(t, confirmRematch(t, g))
+	rematch := currentGame(g)
+
+	time.Sleep(60 * time.Millisecond)
+	require.Same(t, rematch, currentGame(g), "the countdown shouldn't replace the rematch in progress")
+	require.False(t, rematch.IsGameEnded)
+	require.Equal(t, 1, s.registry.count())
+}
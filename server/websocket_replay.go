@@ -0,0 +1,206 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// DefaultReplayPace is how long a replayed game waits between actions.
+const DefaultReplayPace = time.Second
+
+// ReplayFile is a recorded game: the seed it was dealt with (see chinchon.WithSeed), and all its
+// serialized actions in order, including round finished confirmations. The game must have been
+// played with the default rules.
+type ReplayFile struct {
+	Seed    int64             `json:"seed"`
+	Actions []json.RawMessage `json:"actions"`
+}
+
+// LoadReplayFile reads a replay file from path, checking that all its actions can be replayed.
+func LoadReplayFile(path string) (ReplayFile, error) {
+	var replay ReplayFile
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return replay, err
+	}
+	if err := json.Unmarshal(bs, &replay); err != nil {
+		return replay, fmt.Errorf("parsing replay file: %w", err)
+	}
+	if _, err := chinchon.Replay(replay.Seed, replay.actions()); err != nil {
+		return replay, err
+	}
+	return replay, nil
+}
+
+func (r ReplayFile) actions() [][]byte {
+	actions := make([][]byte, len(r.Actions))
+	for i, action := range r.Actions {
+		actions[i] = action
+	}
+	return actions
+}
+
+// WithReplay makes the server replay a recorded game for spectators to watch, e.g. to feature it in
+// a lobby. Spectators connect to /replay and say hello as the player whose point of view they want
+// to watch the game from. They can't run actions. The replay starts when the first spectator
+// connects.
+func WithReplay(replay ReplayFile) func(*server) {
+	return func(s *server) {
+		s.replay = &replay
+	}
+}
+
+// WithReplayPace sets how long the replayed game waits between actions.
+func WithReplayPace(pace time.Duration) func(*server) {
+	return func(s *server) {
+		s.replayPace = pace
+	}
+}
+
+// WithReplayLoop makes the replayed game start over once it ends. Otherwise, it stays at its final
+// state.
+func WithReplayLoop(enabled bool) func(*server) {
+	return func(s *server) {
+		s.isReplayLoop = enabled
+	}
+}
+
+// replayer replays a recorded game at a fixed pace, broadcasting each state to its spectators.
+type replayer struct {
+	replay ReplayFile
+	pace   time.Duration
+	loop   bool
+	start  sync.Once
+
+	// mu guards gameState and spectators. Each spectator maps to the player whose point of view
+	// they watch the game from.
+	mu         sync.Mutex
+	gameState  *chinchon.GameState
+	spectators map[*websocket.Conn]int
+}
+
+func newReplayer(replay ReplayFile, pace time.Duration, loop bool) *replayer {
+	gameState, _ := chinchon.ReplayTo(replay.Seed, replay.actions(), 0)
+	return &replayer{
+		replay:     replay,
+		pace:       pace,
+		loop:       loop,
+		gameState:  gameState,
+		spectators: map[*websocket.Conn]int{},
+	}
+}
+
+// run replays the game, until its end or forever if looping.
+func (r *replayer) run() {
+	for {
+		for i, bs := range r.replay.Actions {
+			time.Sleep(r.pace)
+			if err := r.runAction(bs); err != nil {
+				log.Printf("Stopping replay at action %v: %v", i, err)
+				return
+			}
+		}
+		if !r.loop {
+			return
+		}
+		time.Sleep(r.pace)
+		r.restart()
+	}
+}
+
+func (r *replayer) runAction(bs []byte) error {
+	action, err := chinchon.DeserializeAction(bs)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.gameState.RunAction(action); err != nil {
+		return err
+	}
+	r.broadcastLocked()
+	return nil
+}
+
+func (r *replayer) restart() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gameState, _ = chinchon.ReplayTo(r.replay.Seed, r.replay.actions(), 0)
+	r.broadcastLocked()
+}
+
+// broadcastLocked sends the current game state to all spectators, dropping those whose connection
+// fails. mu must be held.
+func (r *replayer) broadcastLocked() {
+	for conn, playerID := range r.spectators {
+		if err := r.sendLocked(conn, playerID); err != nil {
+			log.Println("Dropping replay spectator:", err)
+			delete(r.spectators, conn)
+		}
+	}
+}
+
+// sendLocked sends the current game state to a spectator. mu must be held.
+func (r *replayer) sendLocked(conn *websocket.Conn, playerID int) error {
+	msg, _ := NewMessageHeresGameState(r.gameState.ToClientGameState(playerID))
+	return WsSend(conn, msg)
+}
+
+func (r *replayer) addSpectator(conn *websocket.Conn, playerID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spectators[conn] = playerID
+	return r.sendLocked(conn, playerID)
+}
+
+func (r *replayer) removeSpectator(conn *websocket.Conn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.spectators, conn)
+}
+
+// handleReplayWebSocket lets a spectator watch the replayed game.
+func (s *server) handleReplayWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Println("Failed to upgrade connection to WebSocket:", err)
+		return
+	}
+	defer conn.Close()
+
+	playerID, err := WsReadMessage[int, MessageHello](conn, MessageTypeHello)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if *playerID < 0 || *playerID > 1 {
+		log.Println("Invalid player ID")
+		return
+	}
+
+	if err := s.replayer.addSpectator(conn, *playerID); err != nil {
+		log.Println(err)
+		return
+	}
+	defer s.replayer.removeSpectator(conn)
+	s.replayer.start.Do(func() { go s.replayer.run() })
+
+	// Spectators can't run actions, so anything they send is ignored until they disconnect.
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,109 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+// recordReplay plays the first turns of a seeded game, returning it as a replay file.
+func recordReplay(t *testing.T) ReplayFile {
+	g := chinchon.New(chinchon.WithSeed(1))
+	for i := 0; i < 2; i++ {
+		playerID := g.TurnPlayerID
+		require.NoError(t, g.RunAction(chinchon.NewActionDrawFromDrawPile(playerID)))
+		require.NoError(t, g.RunAction(chinchon.NewActionDiscardCard(g.Players[playerID].Hand.Revealed[0], playerID)))
+		if g.TurnPlayerID == playerID {
+			require.NoError(t, g.RunAction(chinchon.NewActionEndTurn(playerID)))
+		}
+	}
+	replay := ReplayFile{Seed: 1}
+	for _, actionLog := range g.RoundsLog[g.RoundNumber].ActionsLog {
+		replay.Actions = append(replay.Actions, actionLog.Action)
+	}
+	return replay
+}
+
+// spectate connects to the server's replay as a spectator watching from player 0's point of view.
+func spectate(t *testing.T, opts ...func(*server)) *websocket.Conn {
+	ts := httptest.NewServer(New("0", opts...).Handler())
+	t.Cleanup(ts.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+strings.TrimPrefix(ts.URL, "http://")+"/replay", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	require.NoError(t, WsSend(conn, NewMessageHello(0)))
+	return conn
+}
+
+func readGameState(t *testing.T, conn *websocket.Conn) chinchon.ClientGameState {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	gs, err := WsReadMessage[chinchon.ClientGameState, MessageHeresGameState](conn, MessageTypeHeresGameState)
+	require.NoError(t, err)
+	return *gs
+}
+
+func TestReplayBroadcastsActionsInOrderAtThePace(t *testing.T) {
+	const pace = 30 * time.Millisecond
+	replay := recordReplay(t)
+	conn := spectate(t, WithReplay(replay), WithReplayPace(pace))
+
+	dealt := readGameState(t, conn)
+	require.Nil(t, dealt.LastActionLog)
+
+	last := time.Now()
+	for _, action := range replay.Actions {
+		gs := readGameState(t, conn)
+		require.GreaterOrEqual(t, time.Since(last), pace-5*time.Millisecond)
+		last = time.Now()
+
+		require.NotNil(t, gs.LastActionLog)
+		require.JSONEq(t, string(action), string(gs.LastActionLog.Action))
+	}
+}
+
+func TestReplayLoopsWhenConfigured(t *testing.T) {
+	replay := recordReplay(t)
+	conn := spectate(t, WithReplay(replay), WithReplayPace(5*time.Millisecond), WithReplayLoop(true))
+
+	dealt := readGameState(t, conn)
+	for range replay.Actions {
+		readGameState(t, conn)
+	}
+
+	restarted := readGameState(t, conn)
+	require.Nil(t, restarted.LastActionLog)
+	require.Equal(t, dealt.YourHandCards, restarted.YourHandCards)
+}
+
+func TestLoadReplayFile(t *testing.T) {
+	replay := recordReplay(t)
+	path := filepath.Join(t.TempDir(), "replay.json")
+	bs, err := json.Marshal(replay)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bs, 0o644))
+
+	loaded, err := LoadReplayFile(path)
+	require.NoError(t, err)
+	require.Equal(t, replay.Seed, loaded.Seed)
+	require.Len(t, loaded.Actions, len(replay.Actions))
+
+	// Replaying with another seed deals other cards, so the actions can't be run.
+	replay.Seed = 2
+	bs, err = json.Marshal(replay)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bs, 0o644))
+	_, err = LoadReplayFile(path)
+	require.Error(t, err)
+}
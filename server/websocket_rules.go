@@ -0,0 +1,132 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"errors"
+	"log"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+var errRulesNotAgreed = errors.New("rules not agreed yet")
+
+// WithRulesNegotiation makes players agree on the rules of each game before it's dealt, so that
+// clients with different rules can't play out of sync. Each player proposes rules (see
+// MessageProposeRules), and accepts the other's by proposing the same ones. Every proposal is
+// forwarded to both players (see MessageHeresRulesProposals), and the game is created once both
+// proposals are equal. Until then, actions are refused. Proposals that fail Rules.Validate are
+// rejected (see MessageRulesRejected). Rematches keep the agreed rules.
+//
+// The agreed rules are applied on top of the server's own options, like
+// WithAutoDiscardSingleOption, and the game factory (see WithGameFactory) isn't used.
+func WithRulesNegotiation(enabled bool) func(*server) {
+	return func(s *server) {
+		s.isRulesNegotiation = enabled
+	}
+}
+
+// WithHostRules imposes the rules of the server's games, so players don't negotiate them even with
+// WithRulesNegotiation.
+func WithHostRules(rules chinchon.Rules) func(*server) {
+	return func(s *server) {
+		s.hostRules = &rules
+	}
+}
+
+// newGameState creates a game with the given rules on top of the server's options, or with the
+// game factory if there are no rules.
+func (s *server) newGameState(rules *chinchon.Rules) *chinchon.GameState {
+	if rules == nil {
+		return s.gameFactory()
+	}
+	opts := append(append([]func(*chinchon.GameState){}, s.gameOpts...), rules.Options()...)
+	return chinchon.New(opts...)
+}
+
+// rulesProposals returns a copy of the rules proposed so far, and false if the rules were already
+// agreed.
+func (g *hostedGame) rulesProposals() (map[int]chinchon.Rules, bool) {
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	if g.gameState != nil {
+		return nil, false
+	}
+	proposals := map[int]chinchon.Rules{}
+	for playerID, rules := range g.proposals {
+		proposals[playerID] = rules
+	}
+	return proposals, true
+}
+
+// proposeRules records the player's proposal, creating the game if it matches the other player's.
+// Otherwise, the proposals so far are sent to both players. Invalid rules are rejected without
+// being recorded, so they can never be agreed.
+func (g *hostedGame) proposeRules(playerID int, rules chinchon.Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	g.gameMu.Lock()
+	defer g.gameMu.Unlock()
+
+	if g.gameState != nil {
+		log.Println("Ignoring rules proposed by player", playerID, "of game", g.id, "after the game started")
+		return nil
+	}
+	g.proposals[playerID] = rules
+	if g.isRulesAgreedLocked() {
+		log.Println("Players agreed on the rules of game", g.id)
+		g.gameState = g.server.newGameState(&rules)
+		g.proposals = nil
+		g.broadcastLocked()
+		return nil
+	}
+	for i := range g.players {
+		g.sendRulesProposals(i, g.proposals)
+	}
+	return nil
+}
+
+// isRulesAgreedLocked returns true if every player proposed the same rules. gameMu must be held.
+func (g *hostedGame) isRulesAgreedLocked() bool {
+	if len(g.proposals) < len(g.players) {
+		return false
+	}
+	for _, rules := range g.proposals {
+		if rules != g.proposals[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// sendRulesProposals sends the rules proposed so far to a player, if they are connected.
+func (g *hostedGame) sendRulesProposals(playerID int, proposals map[int]chinchon.Rules) {
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+
+	conn := g.players[playerID]
+	if conn == nil {
+		return
+	}
+	if err := WsSend(conn, NewMessageHeresRulesProposals(proposals)); err != nil {
+		log.Println(err)
+	}
+}
+
+// sendRulesRejected tells a player why their rules proposal was rejected, if they are connected.
+func (g *hostedGame) sendRulesRejected(playerID int, err error) {
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+
+	conn := g.players[playerID]
+	if conn == nil {
+		return
+	}
+	if err := WsSend(conn, NewMessageRulesRejected(err.Error())); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,122 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+// negotiate connects both players to the server's default game, returning their connections once
+// each has been sent the (empty) rules proposals.
+func negotiate(t *testing.T, s *server) []*websocket.Conn {
+	ts := httptest.NewServer(http.HandlerFunc(s.handleWebSocket))
+	t.Cleanup(ts.Close)
+
+	conns := []*websocket.Conn{}
+	for playerID := 0; playerID < 2; playerID++ {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		require.NoError(t, WsSend(conn, NewMessageHello(playerID)))
+		require.Empty(t, readRulesProposals(t, conn))
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+func readRulesProposals(t *testing.T, conn *websocket.Conn) map[int]chinchon.Rules {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	proposals, err := WsReadMessage[map[int]chinchon.Rules, MessageHeresRulesProposals](conn, MessageTypeHeresRulesProposals)
+	require.NoError(t, err)
+	return *proposals
+}
+
+func shortGameRules() chinchon.Rules {
+	rules := chinchon.DefaultRules()
+	rules.MaxPoints = 50
+	return rules
+}
+
+func TestRulesAgreementStartsTheGame(t *testing.T) {
+	s := New("0", WithBroadcastWindow(0), WithRulesNegotiation(true))
+	conns := negotiate(t, s)
+	rules := shortGameRules()
+
+	require.NoError(t, WsSend(conns[0], NewMessageProposeRules(rules)))
+	for _, conn := range conns {
+		require.Equal(t, map[int]chinchon.Rules{0: rules}, readRulesProposals(t, conn))
+	}
+	require.Nil(t, currentGame(defaultGame(s)))
+
+	require.NoError(t, WsSend(conns[1], NewMessageProposeRules(rules)))
+	for _, conn := range conns {
+		require.Equal(t, 50, readGameState(t, conn).RuleMaxPoints)
+	}
+	require.Equal(t, rules, currentGame(defaultGame(s)).Rules())
+}
+
+func TestRulesDisagreementBlocksTheGame(t *testing.T) {
+	s := New("0", WithBroadcastWindow(0), WithRulesNegotiation(true))
+	conns := negotiate(t, s)
+	g := defaultGame(s)
+
+	require.NoError(t, WsSend(conns[0], NewMessageProposeRules(chinchon.DefaultRules())))
+	readRulesProposals(t, conns[1])
+	require.NoError(t, WsSend(conns[1], NewMessageProposeRules(shortGameRules())))
+	expected := map[int]chinchon.Rules{0: chinchon.DefaultRules(), 1: shortGameRules()}
+	require.Equal(t, expected, readRulesProposals(t, conns[1]))
+
+	require.Nil(t, currentGame(g))
+	require.ErrorIs(t, g.runAction(chinchon.NewActionDrawFromDrawPile(0)), errRulesNotAgreed)
+
+	require.NoError(t, WsSend(conns[1], NewMessageGimmeGameState()))
+	require.Equal(t, expected, readRulesProposals(t, conns[1]), "players are sent the proposals until they agree")
+}
+
+func TestInvalidRulesAreRejected(t *testing.T) {
+	s := New("0", WithBroadcastWindow(0), WithRulesNegotiation(true))
+	conns := negotiate(t, s)
+	g := defaultGame(s)
+	rules := chinchon.DefaultRules()
+	rules.HandSize = 30
+
+	for _, conn := range conns {
+		require.NoError(t, WsSend(conn, NewMessageProposeRules(rules)))
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		reason, err := WsReadMessage[string, MessageRulesRejected](conn, MessageTypeRulesRejected)
+		require.NoError(t, err)
+		require.Contains(t, *reason, "hand size")
+	}
+
+	require.Nil(t, currentGame(g))
+	proposals, ok := g.rulesProposals()
+	require.True(t, ok)
+	require.Empty(t, proposals)
+	require.ErrorIs(t, g.proposeRules(0, chinchon.Rules{}), chinchon.ErrInvalidRules)
+}
+
+func TestHostRulesAreImposed(t *testing.T) {
+	s := New("0", WithRulesNegotiation(true), WithHostRules(shortGameRules()))
+
+	require.Equal(t, shortGameRules(), currentGame(defaultGame(s)).Rules())
+}
+
+func TestAgreedRulesAreKeptForRematches(t *testing.T) {
+	s := New("0", WithBroadcastWindow(0), WithRulesNegotiation(true), WithAutoRematch(true), WithAutoRematchCountdown(10*time.Millisecond))
+	g := defaultGame(s)
+	require.NoError(t, g.proposeRules(0, shortGameRules()))
+	require.NoError(t, g.proposeRules(1, shortGameRules()))
+	endedGame := endGame(g)
+
+	require.Eventually(t, func() bool { return currentGame(g) != endedGame }, time.Second, 5*time.Millisecond)
+	require.Equal(t, shortGameRules(), currentGame(g).Rules())
+}
@@ -6,7 +6,11 @@ package server
 import (
 	"encoding/json"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
@@ -21,20 +25,201 @@ var upgrader = websocket.Upgrader{
 
 // TODO: resources shouldn't be shared between goroutines! It's not panicking due to insufficient testing for now.
 type server struct {
-	gameState *chinchon.GameState
-	port      string
-	players   []*websocket.Conn
+	port     string
+	registry *gameRegistry
+
+	// defaultGameID is the game that players join when they don't ask for a specific one. It's
+	// replaced by a new game once it ends or is terminated (see currentDefaultGame).
+	defaultGameID string
+	defaultGameMu sync.Mutex
+
+	broadcastWindow time.Duration
+
+	minLatency, maxLatency time.Duration
+
+	isAnalysisMode            bool
+	isAutoDiscardSingleOption bool
+	gameOpts                  []func(*chinchon.GameState)
+	gameFactory               func() *chinchon.GameState
+
+	isRulesNegotiation bool
+	hostRules          *chinchon.Rules
+
+	isAutoRematch        bool
+	autoRematchCountdown time.Duration
+
+	confirmTimeout time.Duration
+
+	maxGames int
+	gameTTL  time.Duration
+	store    GameStore
+
+	illegalActionLogger *slog.Logger
+	maxIllegalActions   int
+
+	replay       *ReplayFile
+	replayPace   time.Duration
+	isReplayLoop bool
+	replayer     *replayer
+}
+
+// WithBroadcastWindow sets how long the server coalesces game state updates for a player before
+// sending them. A zero window sends every update immediately.
+func WithBroadcastWindow(window time.Duration) func(*server) {
+	return func(s *server) {
+		s.broadcastWindow = window
+	}
+}
+
+// WithAnalysisMode makes the server answer analysis state requests with the full-information
+// view of the game (see chinchon.GameState.ToAnalysisGameState), for coaching and debugging.
+//
+// Analysis state is only served to connections from the local machine. Never enable this for
+// competitive play.
+func WithAnalysisMode(enabled bool) func(*server) {
+	return func(s *server) {
+		s.isAnalysisMode = enabled
+	}
+}
+
+// WithAutoDiscardSingleOption makes the server's games discard automatically when there's only one
+// legal discard (see chinchon.WithAutoDiscardSingleOption), saving a client round-trip.
+func WithAutoDiscardSingleOption(enabled bool) func(*server) {
+	return func(s *server) {
+		s.isAutoDiscardSingleOption = enabled
+	}
+}
+
+// WithMaxGames sets the maximum number of games the server hosts simultaneously, including the
+// default game. Past the limit, the lobby refuses to create new games until one ends. Zero means
+// no limit.
+func WithMaxGames(maxGames int) func(*server) {
+	return func(s *server) {
+		s.maxGames = maxGames
+	}
+}
+
+// WithGameTTL makes the server evict games that haven't been accessed for longer than ttl,
+// disconnecting their players, to free the memory held by abandoned games. Joining a game and any
+// message from its players count as accesses. The default game is never evicted. Evicted games are
+// saved first if the server has a store (see WithGameStore). Zero (the default) means never
+// evicting games.
+func WithGameTTL(ttl time.Duration) func(*server) {
+	return func(s *server) {
+		s.gameTTL = ttl
+	}
+}
+
+// GameStore persists the server's games, e.g. so that idle games evicted from memory can be
+// inspected or resumed later.
+type GameStore interface {
+	// SaveGame saves the game with the given ID, serialized with chinchon.GameState.Serialize, so
+	// that chinchon.Deserialize can restore it.
+	SaveGame(gameID string, serialized []byte) error
+}
+
+// WithGameStore makes the server save each game to the store before evicting it (see WithGameTTL).
+// Games still negotiating their rules have nothing to save. Failing to save a game doesn't stop its
+// eviction; the error is logged.
+func WithGameStore(store GameStore) func(*server) {
+	return func(s *server) {
+		s.store = store
+	}
+}
+
+// WithGameFactory makes the server create its games with the given factory instead of
+// chinchon.New, e.g. so that integration tests can deal seeded or fixed decks (see chinchon.WithSeed
+// and chinchon.NewFromDeck). The server's game rules, like WithAutoDiscardSingleOption, are then up
+// to the factory. Rematches aren't created by the factory, but from the finished game (see
+// WithAutoRematch), so they keep its rules and are dealt afresh.
+func WithGameFactory(factory func() *chinchon.GameState) func(*server) {
+	return func(s *server) {
+		s.gameFactory = factory
+	}
+}
+
+func New(port string, opts ...func(*server)) *server {
+	s := &server{
+		port:                 port,
+		broadcastWindow:      DefaultBroadcastWindow,
+		autoRematchCountdown: DefaultAutoRematchCountdown,
+		illegalActionLogger:  defaultIllegalActionLogger(),
+		replayPace:           DefaultReplayPace,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.isAnalysisMode {
+		s.gameOpts = append(s.gameOpts, chinchon.WithAnalysisMode(true))
+	}
+	if s.isAutoDiscardSingleOption {
+		s.gameOpts = append(s.gameOpts, chinchon.WithAutoDiscardSingleOption(true))
+	}
+	if s.gameFactory == nil {
+		s.gameFactory = func() *chinchon.GameState { return chinchon.New(s.gameOpts...) }
+	}
+	if s.replay != nil {
+		s.replayer = newReplayer(*s.replay, s.replayPace, s.isReplayLoop)
+	}
+	s.registry = newGameRegistry(s.maxGames)
+	s.currentDefaultGame()
+	if s.gameTTL > 0 {
+		s.registry.startJanitor(s.gameTTL, func(g *hostedGame) {
+			log.Println("Evicting idle game", g.id)
+			g.save()
+			g.terminate()
+		})
+	}
+	return s
+}
+
+// Close stops the server's background work, such as evicting idle games (see WithGameTTL). It
+// doesn't disconnect players.
+func (s *server) Close() {
+	s.registry.close()
 }
 
-func New(port string) *server {
-	return &server{gameState: chinchon.New(), port: port, players: []*websocket.Conn{nil, nil}}
+// createGame creates a new game with the server's rules, unless the server is full.
+func (s *server) createGame() (*hostedGame, error) {
+	return s.registry.create(func(id string) *hostedGame { return newHostedGame(id, s) })
+}
+
+// currentDefaultGame returns the game that players join when they don't ask for a specific one. If
+// the previous default game ended or was terminated, a new one is created in its place, unless the
+// server is full.
+func (s *server) currentDefaultGame() (*hostedGame, bool) {
+	s.defaultGameMu.Lock()
+	defer s.defaultGameMu.Unlock()
+
+	if g, ok := s.registry.get(s.defaultGameID); ok {
+		return g, true
+	}
+	g, err := s.createGame()
+	if err != nil {
+		log.Println("Failed to create the default game:", err)
+		return nil, false
+	}
+	log.Println("Created default game", g.id)
+	s.defaultGameID = g.id
+	s.registry.pin(g.id)
+	return g, true
 }
 
 func (s *server) Start() {
+	log.Printf("Server running on port %v\n", s.port)
+	log.Fatal(http.ListenAndServe(":"+s.port, s.Handler()))
+}
+
+// Handler returns the server's HTTP handler, e.g. to serve it from a test server.
+func (s *server) Handler() http.Handler {
 	router := mux.NewRouter()
 	router.HandleFunc("/ws", s.handleWebSocket)
-	log.Printf("Server running on port %v\n", s.port)
-	log.Fatal(http.ListenAndServe(":"+s.port, router))
+	router.HandleFunc("/games", s.handleCreateGame).Methods(http.MethodPost)
+	router.HandleFunc("/games/{gameID}", s.handleTerminateGame).Methods(http.MethodDelete)
+	if s.replayer != nil {
+		router.HandleFunc("/replay", s.handleReplayWebSocket)
+	}
+	return router
 }
 
 func (s *server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
@@ -45,6 +230,22 @@ func (s *server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 	}
 	defer conn.Close()
 
+	// Players join the default game unless they ask for a specific one, e.g. /ws?gameID=2.
+	gameID := r.URL.Query().Get("gameID")
+	var (
+		g  *hostedGame
+		ok bool
+	)
+	if gameID != "" {
+		g, ok = s.registry.get(gameID)
+	} else {
+		g, ok = s.currentDefaultGame()
+	}
+	if !ok {
+		log.Println("Game", gameID, "not found")
+		return
+	}
+
 	playerID, err := WsReadMessage[int, MessageHello](conn, MessageTypeHello)
 	if err != nil {
 		log.Println(err)
@@ -55,28 +256,35 @@ func (s *server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 		log.Println("Invalid player ID")
 		return
 	}
-	if s.players[*playerID] != nil {
+	g.writeMu.Lock()
+	if g.players[*playerID] != nil {
+		g.writeMu.Unlock()
 		log.Println("Player already connected")
 		return
 	}
-	s.players[*playerID] = conn
+	g.players[*playerID] = conn
+	g.writeMu.Unlock()
 
-	msg, _ := NewMessageHeresGameState(s.gameState.ToClientGameState(*playerID))
-	if err := WsSend(conn, msg); err != nil {
-		log.Println(err)
-		return
+	if proposals, ok := g.rulesProposals(); ok {
+		g.sendRulesProposals(*playerID, proposals)
+	} else {
+		g.deliverGameState(*playerID, g.clientGameState(*playerID))
 	}
-	log.Println("Player", *playerID, "connected")
+	log.Println("Player", *playerID, "connected to game", g.id)
+
+	illegalActions := &illegalActionTracker{logger: s.illegalActionLogger, max: s.maxIllegalActions, gameID: g.id, playerID: *playerID}
 
 	for {
 		log.Println("Waiting for action/state_request from player", *playerID)
 		_, message, err := conn.ReadMessage()
 		if err != nil {
 			log.Println("Failed to read message from client, freeing slot:", err)
-			s.players[*playerID] = nil
+			g.disconnect(*playerID)
 			break
 		}
 
+		s.registry.touch(g.id)
+
 		var wsMessage WebsocketMessage
 		if err := json.Unmarshal(message, &wsMessage); err != nil {
 			log.Println("Failed to unmarshal message:", err)
@@ -92,33 +300,79 @@ func (s *server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 			if (*action).GetPlayerID() != *playerID {
-				log.Fatal("Player", *playerID, " tried to run action for player", (*action).GetPlayerID())
+				err = errActionForAnotherPlayer
+			} else {
+				err = g.runAction(*action)
 			}
-			err = s.gameState.RunAction(*action)
 			if err != nil {
 				// TODO write back to the connection
-				log.Println("Failed to run action:", err)
+				if illegalActions.record(*action, err) {
+					g.disconnect(*playerID)
+					return
+				}
 				break
 			}
 
 			log.Println("Ran action message:", string(message))
-
-			for i, playerConn := range s.players {
-				log.Println("Sending game state to player", i)
-				msg, _ := NewMessageHeresGameState(s.gameState.ToClientGameState(i))
-				if err := WsSend(playerConn, msg); err != nil {
-					log.Println(err)
-					return
-				}
-			}
 		case MessageTypeGimmeGameState:
 			log.Println("Got state request message:", string(message))
 
-			msg, _ := NewMessageHeresGameState(s.gameState.ToClientGameState(*playerID))
-			if err := WsSend(conn, msg); err != nil {
+			if proposals, ok := g.rulesProposals(); ok {
+				g.sendRulesProposals(*playerID, proposals)
+				continue
+			}
+			g.broadcaster.enqueue(*playerID, g.clientGameState(*playerID))
+		case MessageTypeProposeRules:
+			log.Println("Got rules proposal message:", string(message))
+			rules, err := WsDeserializeMessage[chinchon.Rules, MessageProposeRules](message, MessageTypeProposeRules)
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			if err := g.proposeRules(*playerID, *rules); err != nil {
+				log.Println("Rejected rules proposed by player", *playerID, "of game", g.id+":", err)
+				g.sendRulesRejected(*playerID, err)
+			}
+		case MessageTypeOptOutOfRematch:
+			log.Println("Got rematch opt out message:", string(message))
+
+			g.optOutOfRematch(*playerID)
+		case MessageTypeGimmeAnalysisGameState:
+			log.Println("Got analysis state request message:", string(message))
+
+			if !s.isAnalysisMode || !isLocalRequest(r) {
+				log.Println("Refusing analysis state request from player", *playerID)
+				continue
+			}
+			g.gameMu.Lock()
+			var analysisGameState chinchon.AnalysisGameState
+			err := errRulesNotAgreed
+			if g.gameState != nil {
+				analysisGameState, err = g.gameState.ToAnalysisGameState()
+			}
+			g.gameMu.Unlock()
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			msg, _ := NewMessageHeresAnalysisGameState(analysisGameState)
+			g.writeMu.Lock()
+			err = WsSend(conn, msg)
+			g.writeMu.Unlock()
+			if err != nil {
 				log.Println(err)
 				return
 			}
 		}
 	}
 }
+
+// isLocalRequest returns true if the request comes from the local machine.
+func isLocalRequest(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,123 @@
+//go:build !tinygo
+// +build !tinygo
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoDiscardSingleOptionAppliesToNewGames(t *testing.T) {
+	s := New("0", WithAutoDiscardSingleOption(true))
+	_, resp := createGame(t, s)
+
+	for _, id := range []string{s.defaultGameID, resp.GameID} {
+		g, ok := s.registry.get(id)
+		require.True(t, ok)
+		require.True(t, currentGame(g).RuleAutoDiscardSingleOption)
+	}
+}
+
+// fixedDeckFactory creates games that are always dealt the default deck in reverse order.
+func fixedDeckFactory(t *testing.T) func() *chinchon.GameState {
+	deckOrder := []chinchon.Card{}
+	for _, suit := range []string{chinchon.BASTO, chinchon.ESPADA, chinchon.COPA, chinchon.ORO} {
+		for number := 12; number >= 1; number-- {
+			if number != 8 && number != 9 {
+				deckOrder = append(deckOrder, chinchon.Card{Suit: suit, Number: number})
+			}
+		}
+	}
+	return func() *chinchon.GameState {
+		gameState, err := chinchon.NewFromDeck(deckOrder)
+		require.NoError(t, err)
+		return gameState
+	}
+}
+
+// joinDefaultGame connects to the server's default game as player 0, returning the first game state.
+func joinDefaultGame(t *testing.T, s *server) chinchon.ClientGameState {
+	ts := httptest.NewServer(http.HandlerFunc(s.handleWebSocket))
+	t.Cleanup(ts.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	require.NoError(t, WsSend(conn, NewMessageHello(0)))
+	return readGameState(t, conn)
+}
+
+func TestGameFactoryMakesServerGamesDeterministic(t *testing.T) {
+	expected, _ := json.Marshal(fixedDeckFactory(t)().ToClientGameState(0))
+
+	for i := 0; i < 2; i++ {
+		s := New("0", WithBroadcastWindow(0), WithGameFactory(fixedDeckFactory(t)))
+		actual, _ := json.Marshal(joinDefaultGame(t, s))
+		require.JSONEq(t, string(expected), string(actual))
+	}
+}
+
+func TestRematchesAreBuiltFromTheFinishedGame(t *testing.T) {
+	created := 0
+	s := New("0", WithAutoRematch(true), WithAutoRematchCountdown(10*time.Millisecond), WithGameFactory(func() *chinchon.GameState {
+		created++
+		return chinchon.New(chinchon.WithMaxPoints(50))
+	}))
+	g := defaultGame(s)
+	endedGame := endGame(g)
+
+	require.Eventually(t, func() bool { return currentGame(g) != endedGame }, time.Second, 5*time.Millisecond)
+	require.Equal(t, 1, created, "the factory only creates the first game")
+	require.Equal(t, endedGame.Rules(), currentGame(g).Rules())
+	require.Equal(t, endedGame.PlayerOrder, currentGame(g).PlayerOrder)
+}
+
+// loopingPlayer always takes the top discard and discards the card they took on their previous
+// turn, so that both players keep passing the same cards around.
+type loopingPlayer struct {
+	taken, discard chinchon.Card
+}
+
+func (p *loopingPlayer) chooseAction(gs chinchon.ClientGameState) chinchon.Action {
+	for _, raw := range gs.PossibleActions {
+		action, _ := chinchon.DeserializeAction(raw)
+		switch action.GetName() {
+		case chinchon.DRAW_FROM_DISCARD_PILE:
+			p.taken, p.discard = gs.DiscardPileTopCard, p.taken
+			return action
+		case chinchon.DISCARD_CARD:
+			if p.discard == (chinchon.Card{}) {
+				return action
+			}
+			return chinchon.NewActionDiscardCard(p.discard, gs.YouPlayerID)
+		case chinchon.END_TURN:
+			return action
+		}
+	}
+	return nil
+}
+
+func TestStalledGameEndsWithoutRejectingTheAction(t *testing.T) {
+	s := New("0", WithBroadcastWindow(0), WithGameFactory(func() *chinchon.GameState {
+		return chinchon.New(chinchon.WithStallDetection(3))
+	}))
+	g := defaultGame(s)
+	players := map[int]*loopingPlayer{0: {}, 1: {}}
+
+	for i := 0; i < 100 && !currentGame(g).IsGameEnded; i++ {
+		gs := currentGame(g)
+		require.NoError(t, g.runAction(players[gs.TurnPlayerID].chooseAction(gs.ToClientGameState(gs.TurnPlayerID))))
+	}
+
+	require.True(t, currentGame(g).IsStallDetected)
+	require.Zero(t, s.registry.count(), "the aborted game's slot is freed")
+}
@@ -0,0 +1,79 @@
+// Package session tracks cumulative results across several Chinchón games played between the
+// same players, e.g. for the running tally ("pozo") of a persistent lobby.
+package session
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+)
+
+// Standing is a player's cumulative result over all games recorded in a session.
+type Standing struct {
+	PlayerID  int `json:"playerID"`
+	GamesWon  int `json:"gamesWon"`
+	Points    int `json:"points"`
+	RoundsWon int `json:"roundsWon"`
+	Gins      int `json:"gins"`
+	Undercuts int `json:"undercuts"`
+}
+
+// Session accumulates the results of finished games between the same players.
+type Session struct {
+	// GamesPlayed is the number of games recorded.
+	GamesPlayed int `json:"gamesPlayed"`
+
+	standings map[int]*Standing
+}
+
+var errGameNotEnded = errors.New("game is not ended")
+
+func New() *Session {
+	return &Session{standings: map[int]*Standing{}}
+}
+
+// RecordGame adds the results of a finished game to the session.
+func (s *Session) RecordGame(g *chinchon.GameState) error {
+	if !g.IsGameEnded {
+		return errGameNotEnded
+	}
+
+	stats := chinchon.ComputeStats(g)
+	for playerID, playerStats := range stats.Players {
+		standing, ok := s.standings[playerID]
+		if !ok {
+			standing = &Standing{PlayerID: playerID}
+			s.standings[playerID] = standing
+		}
+		standing.Points += playerStats.Score
+		standing.RoundsWon += playerStats.RoundsWon
+		standing.Gins += playerStats.Gins
+		standing.Undercuts += playerStats.Undercuts
+		if playerID == g.WinnerPlayerID {
+			standing.GamesWon++
+		}
+	}
+	s.GamesPlayed++
+
+	return nil
+}
+
+// Standings returns each player's cumulative standing, ordered by games won, then points, then
+// player ID.
+func (s *Session) Standings() []Standing {
+	standings := []Standing{}
+	for _, standing := range s.standings {
+		standings = append(standings, *standing)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].GamesWon != standings[j].GamesWon {
+			return standings[i].GamesWon > standings[j].GamesWon
+		}
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		return standings[i].PlayerID < standings[j].PlayerID
+	})
+	return standings
+}
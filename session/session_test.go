@@ -0,0 +1,51 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/marianogappa/chinchon-backend/chinchon"
+	"github.com/stretchr/testify/require"
+)
+
+func finishedGame(winnerPlayerID int, scores map[int]int, roundsLog []*chinchon.RoundLog) *chinchon.GameState {
+	g := chinchon.New()
+	for playerID, score := range scores {
+		g.Players[playerID].Score = score
+	}
+	g.IsGameEnded = true
+	g.WinnerPlayerID = winnerPlayerID
+	g.RoundsLog = append([]*chinchon.RoundLog{{}}, roundsLog...)
+	// The game ended by finishing its last round
+	g.RoundNumber = len(roundsLog)
+	g.IsRoundFinished = true
+	return g
+}
+
+func TestSessionStandings(t *testing.T) {
+	s := New()
+
+	require.NoError(t, s.RecordGame(finishedGame(0, map[int]int{0: 100, 1: 60}, []*chinchon.RoundLog{
+		{KnockedPlayerID: 0, WinnerPlayerID: 0, WinnerDeadwoodPoints: 0, PointsAwarded: 100},
+		{KnockedPlayerID: 1, WinnerPlayerID: 1, WinnerDeadwoodPoints: 4, PointsAwarded: 60},
+	})))
+	require.NoError(t, s.RecordGame(finishedGame(1, map[int]int{0: 30, 1: 100}, []*chinchon.RoundLog{
+		{KnockedPlayerID: 0, WinnerPlayerID: 1, WinnerDeadwoodPoints: 2, PointsAwarded: 100},
+	})))
+	require.NoError(t, s.RecordGame(finishedGame(1, map[int]int{0: 0, 1: 100}, []*chinchon.RoundLog{
+		{KnockedPlayerID: 1, WinnerPlayerID: 1, WinnerDeadwoodPoints: 0, PointsAwarded: 100},
+	})))
+
+	require.Equal(t, 3, s.GamesPlayed)
+	require.Equal(t, []Standing{
+		{PlayerID: 1, GamesWon: 2, Points: 260, RoundsWon: 3, Gins: 1, Undercuts: 1},
+		{PlayerID: 0, GamesWon: 1, Points: 130, RoundsWon: 1, Gins: 1, Undercuts: 0},
+	}, s.Standings())
+}
+
+func TestSessionRejectsUnfinishedGames(t *testing.T) {
+	s := New()
+
+	require.ErrorIs(t, s.RecordGame(chinchon.New()), errGameNotEnded)
+	require.Equal(t, 0, s.GamesPlayed)
+	require.Empty(t, s.Standings())
+}